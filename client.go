@@ -2,6 +2,7 @@ package rueidis
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/rueian/rueidis/internal/cmds"
@@ -17,16 +18,23 @@ type SingleClientOption struct {
 type SingleClient struct {
 	Cmd  *cmds.Builder
 	conn conn
+	busy *inflight
 }
 
 func newSingleClient(opt SingleClientOption, connFn connFn) (*SingleClient, error) {
-	s := &SingleClient{Cmd: cmds.NewBuilder(), conn: connFn(opt.Address, opt.ConnOption)}
+	s := &SingleClient{Cmd: cmds.NewBuilder(), conn: connFn(opt.Address, opt.ConnOption), busy: newInflight()}
 
 	if err := s.conn.Dial(); err != nil {
 		return nil, err
 	}
 
-	opt.ConnOption.PubSubHandlers.installHook(s.Cmd, func() conn { return s.conn })
+	opt.ConnOption.PubSubHandlers.installHook(s.Cmd, func() conn {
+		if s.busy.enter() {
+			s.busy.leave()
+			return s.conn
+		}
+		return nil
+	})
 
 	return s, nil
 }
@@ -36,18 +44,46 @@ func (c *SingleClient) Info() map[string]proto.Message {
 }
 
 func (c *SingleClient) Do(ctx context.Context, cmd cmds.Completed) (resp proto.Result) {
-	resp = c.conn.Do(cmd)
+	if c.busy.enter() {
+		resp = c.conn.Do(cmd)
+		c.busy.leave()
+	} else {
+		resp = proto.NewErrResult(ErrConnClosing)
+	}
 	c.Cmd.Put(cmd.Commands())
 	return resp
 }
 
 func (c *SingleClient) DoCache(ctx context.Context, cmd cmds.Cacheable, ttl time.Duration) (resp proto.Result) {
-	resp = c.conn.DoCache(cmd, ttl)
+	if c.busy.enter() {
+		resp = c.conn.DoCache(cmd, ttl)
+		c.busy.leave()
+	} else {
+		resp = proto.NewErrResult(ErrConnClosing)
+	}
 	c.Cmd.Put(cmd.Commands())
 	return resp
 }
 
+// DoStream returns a ResultStream which sends the cmd through a connection from the blocking pool
+// and streams its blob string reply, such as the one of GET or DUMP, to a writer. It is suitable for large values.
+// The deadline and the cancellation of the ctx are applied to the connection while streaming,
+// but waiting for an idle connection from the blocking pool is not interrupted by the ctx.
+func (c *SingleClient) DoStream(ctx context.Context, cmd cmds.Completed) ResultStream {
+	return ResultStream{fn: func(w io.Writer) (int64, error) {
+		if !c.busy.enter() {
+			return 0, ErrConnClosing
+		}
+		defer c.busy.leave()
+		return c.conn.DoStream(ctx, cmd, w)
+	}}
+}
+
 func (c *SingleClient) Dedicated(fn func(*DedicatedSingleClient) error) (err error) {
+	if !c.busy.enter() {
+		return ErrConnClosing
+	}
+	defer c.busy.leave()
 	wire := c.conn.Acquire()
 	err = fn(&DedicatedSingleClient{Cmd: c.Cmd, wire: wire})
 	c.conn.Store(wire)
@@ -84,8 +120,17 @@ func (c *SingleClient) NewHashRepository(prefix string, schema interface{}) *om.
 	})
 }
 
-func (c *SingleClient) Close() {
+// Close stops accepting new commands and waits for the in-flight commands, including Dedicated calls, to be answered.
+// Then it closes the underlying connections, the blocking pool, and stops the PubSub hook from reconnecting.
+// If the ctx is done before the in-flight commands are answered, the connections are closed in the background
+// and a *CloseError reporting the number of aborted commands is returned.
+func (c *SingleClient) Close(ctx context.Context) error {
+	if err := c.busy.drain(ctx); err != nil {
+		go c.conn.Close()
+		return err
+	}
 	c.conn.Close()
+	return nil
 }
 
 type DedicatedSingleClient struct {
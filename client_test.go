@@ -3,6 +3,7 @@ package rueidis
 import (
 	"context"
 	"errors"
+	"io"
 	"reflect"
 	"strings"
 	"testing"
@@ -14,15 +15,16 @@ import (
 )
 
 type MockConn struct {
-	DoFn      func(cmd cmds.Completed) proto.Result
-	DoCacheFn func(cmd cmds.Cacheable, ttl time.Duration) proto.Result
-	DoMultiFn func(multi ...cmds.Completed) []proto.Result
-	InfoFn    func() map[string]proto.Message
-	ErrorFn   func() error
-	CloseFn   func()
-	DialFn    func() error
-	AcquireFn func() wire
-	StoreFn   func(w wire)
+	DoFn       func(cmd cmds.Completed) proto.Result
+	DoCacheFn  func(cmd cmds.Cacheable, ttl time.Duration) proto.Result
+	DoMultiFn  func(multi ...cmds.Completed) []proto.Result
+	DoStreamFn func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error)
+	InfoFn     func() map[string]proto.Message
+	ErrorFn    func() error
+	CloseFn    func()
+	DialFn     func() error
+	AcquireFn  func() wire
+	StoreFn    func(w wire)
 
 	disconnectedFn func(err error)
 }
@@ -68,6 +70,13 @@ func (m *MockConn) DoMulti(multi ...cmds.Completed) []proto.Result {
 	return nil
 }
 
+func (m *MockConn) DoStream(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+	if m.DoStreamFn != nil {
+		return m.DoStreamFn(ctx, cmd, w)
+	}
+	return 0, nil
+}
+
 func (m *MockConn) Info() map[string]proto.Message {
 	if m.InfoFn != nil {
 		return m.InfoFn()
@@ -142,6 +151,21 @@ func TestSingleClient(t *testing.T) {
 		}
 	})
 
+	t.Run("Delegate DoStream", func(t *testing.T) {
+		c := client.Cmd.Get().Key("DoStream").Build()
+		m.DoStreamFn = func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+			if !reflect.DeepEqual(cmd.Commands(), c.Commands()) {
+				t.Fatalf("unexpected command %v", cmd)
+			}
+			n, err := io.WriteString(w, "DoStream")
+			return int64(n), err
+		}
+		buf := &strings.Builder{}
+		if n, err := client.DoStream(context.Background(), c).WriteTo(buf); err != nil || n != 8 || buf.String() != "DoStream" {
+			t.Fatalf("unexpected response %v %v %v", n, err, buf.String())
+		}
+	})
+
 	t.Run("Delegate Info", func(t *testing.T) {
 		m.InfoFn = func() map[string]proto.Message {
 			return map[string]proto.Message{}
@@ -151,15 +175,6 @@ func TestSingleClient(t *testing.T) {
 		}
 	})
 
-	t.Run("Delegate Close", func(t *testing.T) {
-		called := false
-		m.CloseFn = func() { called = true }
-		client.Close()
-		if !called {
-			t.Fatalf("Close is not delegated")
-		}
-	})
-
 	t.Run("Dedicated Err", func(t *testing.T) {
 		v := errors.New("fn err")
 		if err := client.Dedicated(func(client *DedicatedSingleClient) error {
@@ -245,6 +260,32 @@ func TestSingleClient(t *testing.T) {
 			t.Fatalf("unexpected nil repo")
 		}
 	})
+
+	t.Run("Delegate Close", func(t *testing.T) {
+		called := false
+		m.CloseFn = func() { called = true }
+		if err := client.Close(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if !called {
+			t.Fatalf("Close is not delegated")
+		}
+	})
+
+	t.Run("Reject after Close", func(t *testing.T) {
+		if err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).Error(); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.DoCache(context.Background(), client.Cmd.Get().Key("a").Cache(), 100).Error(); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if _, err := client.DoStream(context.Background(), client.Cmd.Get().Key("a").Build()).WriteTo(io.Discard); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.Dedicated(func(client *DedicatedSingleClient) error { return nil }); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
 }
 
 func TestHashObjectSingleClientAdapter(t *testing.T) {
@@ -4,8 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"math/rand"
-	"runtime"
+	"net"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 	"unsafe"
@@ -18,12 +21,66 @@ import (
 var (
 	ErrNoNodes = errors.New("no node to retrieve cluster slots")
 	ErrNoSlot  = errors.New("slot not covered")
+	// ErrMultiSlot is returned if the command built by the Cmd.Split() with keys in different slots is sent by
+	// other than the ClusterClient.Do or the ShardedClient.Do.
+	ErrMultiSlot = errors.New("command with keys in different slots is only supported by ClusterClient.Do and ShardedClient.Do")
+	// ErrTooManyRedirects matches any *TooManyRedirectsError with errors.Is
+	ErrTooManyRedirects = errors.New("too many cluster redirections")
 )
 
+const (
+	// DefaultMaxRedirects is the default of the ClusterClientOption.MaxRedirects.
+	DefaultMaxRedirects = 16
+	// DefaultMaxRetries is the default of the ClusterClientOption.MaxRetries.
+	DefaultMaxRetries = 16
+
+	minRetryBackoff = time.Millisecond
+	maxRetryBackoff = 512 * time.Millisecond
+)
+
+// TooManyRedirectsError is returned if a command is redirected by MOVED or ASK more than the ClusterClientOption.MaxRedirects.
+type TooManyRedirectsError struct {
+	Redirects int
+	// Last is the last redirection, such as "MOVED 3999 127.0.0.1:6381".
+	Last string
+}
+
+func (e *TooManyRedirectsError) Error() string {
+	return fmt.Sprintf("too many cluster redirections (%d), the last one: %s", e.Redirects, e.Last)
+}
+
+func (e *TooManyRedirectsError) Is(target error) bool {
+	return target == ErrTooManyRedirects
+}
+
 type ClusterClientOption struct {
 	InitAddress []string
 	ShuffleInit bool
 	ConnOption  ConnOption
+
+	// RefreshInterval, if positive, refreshes the cluster topology periodically in the background,
+	// so that failovers are noticed even if no MOVED redirection is received. The topology is also refreshed
+	// when a command to a node fails with a network error. The refreshes are deduplicated if they overlap.
+	RefreshInterval time.Duration
+
+	// AddressRemap, if provided, maps the node addresses from the cluster topology and the MOVED and ASK redirections
+	// to the reachable ones, ex. when the cluster is behind a NAT or in containers announcing internal addresses.
+	// It should return the address unchanged if it needs no remapping.
+	AddressRemap func(addr string) string
+
+	// Observer, if provided, is called with the ClusterEvent of topology changes, redirections and refresh failures.
+	// It is called synchronously by the refresh or the command being redirected, so it should not block.
+	// The initial topology is reported as well, as the nodes added and the masters changed from none.
+	Observer func(event ClusterEvent)
+
+	// MaxRedirects limits the MOVED and ASK redirections followed by a command, and a *TooManyRedirectsError is returned
+	// when it is exceeded. The default is DefaultMaxRedirects.
+	MaxRedirects int
+
+	// MaxRetries limits the retries of a command failed with TRYAGAIN, CLUSTERDOWN, LOADING, MASTERDOWN or READONLY,
+	// and the last error is returned when it is reached. The retries are delayed by exponential backoff and stopped
+	// when the ctx is done as well. The default is DefaultMaxRetries.
+	MaxRetries int
 }
 
 type ClusterClient struct {
@@ -35,9 +92,46 @@ type ClusterClient struct {
 	slots  [16384]conn
 	conns  map[string]conn
 	connFn connFn
+	// replicas are the conns made by the ForEachNode to the nodes which are not masters.
+	replicas map[string]conn
+	busy     *inflight
+
+	shards []ClusterShard
+	// redirects are the counts of MOVED and ASK of each slot, which are only accessed atomically.
+	redirects [16384][2]uint32
+	// slotsOnly is set once the CLUSTER SHARDS is rejected by the server. It is only accessed by the _refresh.
+	slotsOnly bool
+}
+
+// ClusterNode is a node of the cluster topology discovered by the ClusterClient.
+type ClusterNode struct {
+	// ID is the node id. It is empty if the server does not report it.
+	ID   string
+	Addr string
+	Role NodeRole
+	// Health is one of "online", "failed" and "loading" reported by the CLUSTER SHARDS.
+	// It is always "online" if the server only supports the CLUSTER SLOTS.
+	Health string
 }
 
 func newClusterClient(opt ClusterClientOption, connFn connFn) (client *ClusterClient, err error) {
+	// the nodes discovered from the cluster topology have no scheme, so the scheme of init addresses applies to all nodes.
+	addresses := make([]string, len(opt.InitAddress))
+	for i, addr := range opt.InitAddress {
+		if network, a, tlsConfig := parseAddr(addr, opt.ConnOption.TLSConfig); network == "tcp" {
+			addr, opt.ConnOption.TLSConfig = a, tlsConfig
+		}
+		addresses[i] = addr
+	}
+	opt.InitAddress = addresses
+
+	if opt.MaxRedirects <= 0 {
+		opt.MaxRedirects = DefaultMaxRedirects
+	}
+	if opt.MaxRetries <= 0 {
+		opt.MaxRetries = DefaultMaxRetries
+	}
+
 	if opt.ShuffleInit {
 		rand.Shuffle(len(opt.InitAddress), func(i, j int) {
 			opt.InitAddress[i], opt.InitAddress[j] = opt.InitAddress[j], opt.InitAddress[i]
@@ -49,6 +143,7 @@ func newClusterClient(opt ClusterClientOption, connFn connFn) (client *ClusterCl
 		opt:    opt,
 		connFn: connFn,
 		conns:  make(map[string]conn),
+		busy:   newInflight(),
 	}
 
 	if _, err = client.init(); err != nil {
@@ -59,11 +154,19 @@ func newClusterClient(opt ClusterClientOption, connFn connFn) (client *ClusterCl
 		return nil, err
 	}
 
+	if opt.RefreshInterval > 0 {
+		go client.refreshPeriodically(opt.RefreshInterval)
+	}
+
 	opt.ConnOption.PubSubHandlers.installHook((*cmds.Builder)(client.Cmd), func() (cc conn) {
+		if !client.busy.enter() {
+			return nil
+		}
 		var err error
 		for cc == nil && err != ErrConnClosing {
 			cc, err = client.pick(cmds.InitSlot)
 		}
+		client.busy.leave()
 		return cc
 	})
 
@@ -90,17 +193,59 @@ func (c *ClusterClient) init() (cc conn, err error) {
 }
 
 func (c *ClusterClient) refresh() (err error) {
-	return c.sc.Do(c._refresh)
+	return c.sc.Do(func() error {
+		err := c._refresh()
+		if err != nil {
+			c.emit(ClusterEvent{Type: EventRefreshFailed, Err: err})
+		}
+		return err
+	})
+}
+
+// refreshPeriodically refreshes the topology at the interval until the client is closed.
+func (c *ClusterClient) refreshPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !c.busy.enter() {
+				return
+			}
+			c.refresh()
+			c.busy.leave()
+		case <-c.busy.done:
+			return
+		}
+	}
+}
+
+// refreshOnNetworkErr refreshes the topology in the background if the err indicates the node may be gone.
+func (c *ClusterClient) refreshOnNetworkErr(err error) {
+	if isNetworkErr(err) {
+		c.lazyRefresh()
+	}
+}
+
+// lazyRefresh refreshes the topology in the background unless the client is closing,
+// so that no connection is made after the Close returns.
+func (c *ClusterClient) lazyRefresh() {
+	go func() {
+		if c.busy.enter() {
+			c.refresh()
+			c.busy.leave()
+		}
+	}()
 }
 
 func (c *ClusterClient) _refresh() (err error) {
-	var reply proto.Message
+	var groups map[string]group
 	var dead []string
 
 retry:
 	c.mu.RLock()
 	for addr, cc := range c.conns {
-		if reply, err = cc.Do(cmds.SlotCmd).Value(); err != nil {
+		if groups, err = c.discover(addr, cc); err != nil {
 			dead = append(dead, addr)
 		} else {
 			break
@@ -124,15 +269,13 @@ retry:
 		return err
 	}
 
-	if len(reply.Values) == 0 {
+	if len(groups) == 0 {
 		if _, err = c.init(); err != nil {
 			return err
 		}
 		goto retry
 	}
 
-	groups := parseSlots(reply)
-
 	// TODO support read from replicas
 	masters := make(map[string]conn, len(groups))
 	for addr := range groups {
@@ -152,26 +295,61 @@ retry:
 	c.mu.RUnlock()
 
 	slots := [16384]conn{}
+	shards := make([]ClusterShard, 0, len(groups))
 	for addr, g := range groups {
+		shard := ClusterShard{Master: g.nodes[0], Replicas: g.nodes[1:]}
 		for _, slot := range g.slots {
 			for i := slot[0]; i <= slot[1]; i++ {
 				slots[i] = masters[addr]
 			}
+			shard.Slots = append(shard.Slots, [2]uint16{uint16(slot[0]), uint16(slot[1])})
 		}
+		shards = append(shards, shard)
 	}
+	sort.Slice(shards, func(i, j int) bool { return shards[i].Slots[0][0] < shards[j].Slots[0][0] })
+
+	var events []ClusterEvent
 
 	c.mu.Lock()
-	c.slots = slots
-	c.conns = masters
+	if c.opt.Observer != nil {
+		events = diffShards(c.shards, shards)
+	}
+	for addr, cc := range c.replicas {
+		if !isReplica(shards, addr) {
+			delete(c.replicas, addr)
+			go cc.Close()
+		}
+	}
+	c.shards = shards
+	// the conns of unchanged masters are reused, so the same topology results in the same slots
+	if slots != c.slots || len(removes) != 0 {
+		c.slots = slots
+		c.conns = masters
+	}
 	c.mu.Unlock()
 
 	for _, cc := range removes {
 		go cc.Close()
 	}
 
+	for _, e := range events {
+		c.emit(e)
+	}
+
 	return nil
 }
 
+func isReplica(shards []ClusterShard, addr string) bool {
+	for _, s := range shards {
+		for _, r := range s.Replicas {
+			if r.Addr == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (c *ClusterClient) nodes() []string {
 	c.mu.RLock()
 	nodes := make([]string, 0, len(c.conns))
@@ -182,30 +360,180 @@ func (c *ClusterClient) nodes() []string {
 	return nodes
 }
 
+// Nodes returns the nodes of the cluster topology from the last refresh, sorted by their addresses.
+func (c *ClusterClient) Nodes() []ClusterNode {
+	var nodes []ClusterNode
+	for _, shard := range c.Topology() {
+		nodes = append(nodes, shard.Master)
+		nodes = append(nodes, shard.Replicas...)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Addr < nodes[j].Addr })
+	return nodes
+}
+
 type group struct {
-	nodes []string
+	nodes []ClusterNode // the first one is the master
 	slots [][2]int64
 }
 
-func parseSlots(slots proto.Message) map[string]group {
-	groups := make(map[string]group, len(slots.Values))
-	for _, v := range slots.Values {
-		master := fmt.Sprintf("%s:%d", v.Values[2].Values[0].String, v.Values[2].Values[1].Integer)
-		g, ok := groups[master]
+// discover retrieves the topology from the node at the addr with the CLUSTER SHARDS,
+// and falls back to the CLUSTER SLOTS if the server is older than redis 7.
+func (c *ClusterClient) discover(addr string, cc conn) (map[string]group, error) {
+	host, _, _ := net.SplitHostPort(addr)
+	tls := c.opt.ConnOption.TLSConfig != nil || c.opt.ConnOption.TLSFiles != nil
+	if !c.slotsOnly {
+		reply, err := cc.Do(cmds.ShardsCmd).ToArray()
+		if err == nil {
+			if isSlotsReply(reply) {
+				return c.remapGroups(parseSlots(reply, host)), nil
+			}
+			return c.remapGroups(parseShards(reply, host, tls)), nil
+		}
+		if _, ok := err.(*proto.RedisError); !ok {
+			return nil, err
+		}
+		c.slotsOnly = true
+	}
+	reply, err := cc.Do(cmds.SlotCmd).ToArray()
+	if err != nil {
+		return nil, err
+	}
+	return c.remapGroups(parseSlots(reply, host)), nil
+}
+
+func (c *ClusterClient) remap(addr string) string {
+	if c.opt.AddressRemap != nil {
+		return c.opt.AddressRemap(addr)
+	}
+	return addr
+}
+
+// remapGroups applies the AddressRemap to the groups, so that the remapped addresses are used as the keys of the conns.
+func (c *ClusterClient) remapGroups(groups map[string]group) map[string]group {
+	if c.opt.AddressRemap == nil {
+		return groups
+	}
+	remapped := make(map[string]group, len(groups))
+	for addr, g := range groups {
+		for i := range g.nodes {
+			g.nodes[i].Addr = c.remap(g.nodes[i].Addr)
+		}
+		remapped[c.remap(addr)] = g
+	}
+	return remapped
+}
+
+// isSlotsReply reports whether the reply is in the CLUSTER SLOTS format, whose entries start with the slot numbers,
+// instead of the "slots" key of the CLUSTER SHARDS entries. Some proxies answer both commands in this format.
+func isSlotsReply(reply []proto.Message) bool {
+	return len(reply) != 0 && len(reply[0].Values) != 0 && reply[0].Values[0].Type == ':'
+}
+
+func parseSlots(slots []proto.Message, host string) map[string]group {
+	groups := make(map[string]group, len(slots))
+	for _, v := range slots {
+		if len(v.Values) < 3 {
+			continue
+		}
+		master := slotNode(v.Values[2], host, RoleMaster)
+		g, ok := groups[master.Addr]
 		if !ok {
 			g.slots = make([][2]int64, 0)
-			g.nodes = make([]string, 0, len(v.Values)-2)
-			for i := 2; i < len(v.Values); i++ {
-				dst := fmt.Sprintf("%s:%d", v.Values[i].Values[0].String, v.Values[i].Values[1].Integer)
-				g.nodes = append(g.nodes, dst)
+			g.nodes = make([]ClusterNode, 0, len(v.Values)-2)
+			g.nodes = append(g.nodes, master)
+			for i := 3; i < len(v.Values); i++ {
+				g.nodes = append(g.nodes, slotNode(v.Values[i], host, RoleReplica))
 			}
 		}
 		g.slots = append(g.slots, [2]int64{v.Values[0].Integer, v.Values[1].Integer})
-		groups[master] = g
+		groups[master.Addr] = g
+	}
+	return groups
+}
+
+// slotNode parses a node of the CLUSTER SLOTS, which is [endpoint, port, id, metadata] and the last two are optional.
+func slotNode(v proto.Message, host string, role NodeRole) (n ClusterNode) {
+	n.Role, n.Health = role, "online"
+	var port int64
+	var meta map[string]proto.Message
+	if len(v.Values) > 1 {
+		port = v.Values[1].Integer
+	}
+	if len(v.Values) > 2 {
+		n.ID = v.Values[2].String
+	}
+	if len(v.Values) > 3 {
+		meta = fields(v.Values[3])
+	}
+	var preferred string
+	if len(v.Values) > 0 {
+		preferred = v.Values[0].String
+	}
+	n.Addr = net.JoinHostPort(endpoint(preferred, meta, host), strconv.FormatInt(port, 10))
+	return n
+}
+
+func parseShards(shards []proto.Message, host string, tls bool) map[string]group {
+	groups := make(map[string]group, len(shards))
+	for _, v := range shards {
+		shard := fields(v)
+		var g group
+		slots := shard["slots"].Values
+		for i := 0; i+1 < len(slots); i += 2 {
+			g.slots = append(g.slots, [2]int64{slots[i].Integer, slots[i+1].Integer})
+		}
+		for _, n := range shard["nodes"].Values {
+			node := shardNode(fields(n), host, tls)
+			if node.Role == RoleMaster {
+				g.nodes = append([]ClusterNode{node}, g.nodes...)
+			} else {
+				g.nodes = append(g.nodes, node)
+			}
+		}
+		// the masters without slots are not routed to, and a shard may have no master during a failover
+		if len(g.slots) == 0 || len(g.nodes) == 0 || g.nodes[0].Role != RoleMaster {
+			continue
+		}
+		groups[g.nodes[0].Addr] = g
 	}
 	return groups
 }
 
+func shardNode(f map[string]proto.Message, host string, tls bool) (n ClusterNode) {
+	n.ID, n.Role, n.Health = f["id"].String, NodeRole(f["role"].String), f["health"].String
+	port := f["port"].Integer
+	if p := f["tls-port"].Integer; p != 0 && (tls || port == 0) {
+		port = p
+	}
+	n.Addr = net.JoinHostPort(endpoint(f["endpoint"].String, f, host), strconv.FormatInt(port, 10))
+	return n
+}
+
+// endpoint returns the preferred endpoint chosen by the cluster-preferred-endpoint-type of the server.
+// A "?" means the preferred hostname is not set, so the ip is used instead.
+// An empty one means the endpoint is unknown, so the host used to retrieve the topology is used.
+func endpoint(preferred string, meta map[string]proto.Message, host string) string {
+	if preferred == "?" {
+		if ip := meta["ip"].String; ip != "" {
+			return ip
+		}
+		return host
+	}
+	if preferred == "" {
+		return host
+	}
+	return preferred
+}
+
+// fields converts the map, or the flattened key value array in RESP2, into a go map. Non-string keys are ignored.
+func fields(m proto.Message) map[string]proto.Message {
+	f := make(map[string]proto.Message, len(m.Values)/2)
+	for i := 0; i+1 < len(m.Values); i += 2 {
+		f[m.Values[i].String] = m.Values[i+1]
+	}
+	return f
+}
+
 func (c *ClusterClient) _pick(slot uint16) (p conn) {
 	c.mu.RLock()
 	if slot == cmds.InitSlot {
@@ -221,6 +549,9 @@ func (c *ClusterClient) _pick(slot uint16) (p conn) {
 }
 
 func (c *ClusterClient) pick(slot uint16) (p conn, err error) {
+	if slot == cmds.MultiSlot {
+		return nil, ErrMultiSlot
+	}
 	if p = c._pick(slot); p == nil {
 		if err := c.refresh(); err != nil {
 			return nil, err
@@ -232,7 +563,9 @@ func (c *ClusterClient) pick(slot uint16) (p conn, err error) {
 	return p, nil
 }
 
+// pickOrNew returns the conn to the redirected addr, which is remapped by the AddressRemap first.
 func (c *ClusterClient) pickOrNew(addr string) (p conn) {
+	addr = c.remap(addr)
 	c.mu.RLock()
 	p = c.conns[addr]
 	c.mu.RUnlock()
@@ -249,6 +582,17 @@ func (c *ClusterClient) pickOrNew(addr string) (p conn) {
 }
 
 func (c *ClusterClient) Do(ctx context.Context, cmd cmds.SCompleted) (resp proto.Result) {
+	if !c.busy.enter() {
+		c.Cmd.Put(cmd.Commands())
+		return proto.NewErrResult(ErrConnClosing)
+	}
+	defer c.busy.leave()
+	if cmd.Slot() == cmds.MultiSlot {
+		resp = doSplit(cmd, func(part cmds.SCompleted) proto.Result { return c.Do(ctx, part) })
+		c.Cmd.Put(cmd.Commands())
+		return resp
+	}
+	var redirects, attempts int
 retry:
 	cc, err := c.pick(cmd.Slot())
 	if err != nil {
@@ -256,17 +600,27 @@ retry:
 		goto ret
 	}
 	resp = cc.Do(cmds.Completed(cmd))
+	c.refreshOnNetworkErr(resp.NonRedisError())
 process:
 	if err := resp.RedisError(); err != nil {
 		if addr, ok := err.IsMoved(); ok {
-			go c.refresh()
+			if redirects++; redirects > c.opt.MaxRedirects {
+				resp = proto.NewErrResult(&TooManyRedirectsError{Redirects: redirects - 1, Last: err.String})
+				goto ret
+			}
+			c.emitRedirect(EventMoved, cmd.Slot(), addr)
+			c.lazyRefresh()
 			resp = c.pickOrNew(addr).Do(cmds.Completed(cmd))
 			goto process
 		} else if addr, ok = err.IsAsk(); ok {
+			if redirects++; redirects > c.opt.MaxRedirects {
+				resp = proto.NewErrResult(&TooManyRedirectsError{Redirects: redirects - 1, Last: err.String})
+				goto ret
+			}
+			c.emitRedirect(EventAsk, cmd.Slot(), addr)
 			resp = c.pickOrNew(addr).DoMulti(cmds.AskingCmd, cmds.Completed(cmd))[1]
 			goto process
-		} else if err.IsTryAgain() {
-			runtime.Gosched()
+		} else if c.shouldRetry(ctx, err, &attempts) {
 			goto retry
 		}
 	}
@@ -276,6 +630,12 @@ ret:
 }
 
 func (c *ClusterClient) DoCache(ctx context.Context, cmd cmds.SCacheable, ttl time.Duration) (resp proto.Result) {
+	if !c.busy.enter() {
+		c.Cmd.Put(cmd.Commands())
+		return proto.NewErrResult(ErrConnClosing)
+	}
+	defer c.busy.leave()
+	var redirects, attempts int
 retry:
 	cc, err := c.pick(cmd.Slot())
 	if err != nil {
@@ -283,18 +643,28 @@ retry:
 		goto ret
 	}
 	resp = cc.DoCache(cmds.Cacheable(cmd), ttl)
+	c.refreshOnNetworkErr(resp.NonRedisError())
 process:
 	if err := resp.RedisError(); err != nil {
 		if addr, ok := err.IsMoved(); ok {
-			go c.refresh()
+			if redirects++; redirects > c.opt.MaxRedirects {
+				resp = proto.NewErrResult(&TooManyRedirectsError{Redirects: redirects - 1, Last: err.String})
+				goto ret
+			}
+			c.emitRedirect(EventMoved, cmd.Slot(), addr)
+			c.lazyRefresh()
 			resp = c.pickOrNew(addr).DoCache(cmds.Cacheable(cmd), ttl)
 			goto process
 		} else if addr, ok = err.IsAsk(); ok {
+			if redirects++; redirects > c.opt.MaxRedirects {
+				resp = proto.NewErrResult(&TooManyRedirectsError{Redirects: redirects - 1, Last: err.String})
+				goto ret
+			}
+			c.emitRedirect(EventAsk, cmd.Slot(), addr)
 			// TODO ASKING OPT-IN Caching
 			resp = c.pickOrNew(addr).DoMulti(cmds.AskingCmd, cmds.Completed(cmd))[1]
 			goto process
-		} else if err.IsTryAgain() {
-			runtime.Gosched()
+		} else if c.shouldRetry(ctx, err, &attempts) {
 			goto retry
 		}
 	}
@@ -303,7 +673,88 @@ ret:
 	return resp
 }
 
+// DoStream is the same as the SingleClient.DoStream, and it follows the MOVED and ASK redirections before anything is streamed.
+// The ASKING and the redirected cmd are sent on the same connection from the blocking pool of the target node.
+func (c *ClusterClient) DoStream(ctx context.Context, cmd cmds.SCompleted) ResultStream {
+	return ResultStream{fn: func(w io.Writer) (n int64, err error) {
+		if !c.busy.enter() {
+			return 0, ErrConnClosing
+		}
+		defer c.busy.leave()
+		var redirects, attempts int
+	retry:
+		cc, err := c.pick(cmd.Slot())
+		if err != nil {
+			return 0, err
+		}
+		n, err = cc.DoStream(ctx, cmds.Completed(cmd), w)
+	process:
+		if e, ok := err.(*proto.RedisError); ok {
+			if addr, ok := e.IsMoved(); ok {
+				if redirects++; redirects > c.opt.MaxRedirects {
+					return n, &TooManyRedirectsError{Redirects: redirects - 1, Last: e.String}
+				}
+				c.emitRedirect(EventMoved, cmd.Slot(), addr)
+				c.lazyRefresh()
+				n, err = c.pickOrNew(addr).DoStream(ctx, cmds.Completed(cmd), w)
+				goto process
+			} else if addr, ok = e.IsAsk(); ok {
+				if redirects++; redirects > c.opt.MaxRedirects {
+					return n, &TooManyRedirectsError{Redirects: redirects - 1, Last: e.String}
+				}
+				c.emitRedirect(EventAsk, cmd.Slot(), addr)
+				cc = c.pickOrNew(addr)
+				wire := cc.Acquire()
+				if err = wire.Do(cmds.AskingCmd).Error(); err == nil {
+					n, err = wire.DoStream(ctx, cmds.Completed(cmd), w)
+				}
+				cc.Store(wire)
+				goto process
+			} else if c.shouldRetry(ctx, e, &attempts) {
+				goto retry
+			}
+		}
+		return n, err
+	}}
+}
+
+// shouldRetry reports whether the command should be retried on the err, which is one of TRYAGAIN, CLUSTERDOWN,
+// LOADING, MASTERDOWN and READONLY. The latter three refresh the topology in the background, because the node may be
+// failed over. It waits for the exponential backoff of the attempts, and returns false if the ctx is done before that
+// or the attempts reach the MaxRetries.
+func (c *ClusterClient) shouldRetry(ctx context.Context, err *proto.RedisError, attempts *int) bool {
+	if *attempts >= c.opt.MaxRetries {
+		return false
+	}
+	switch {
+	case err.IsTryAgain(), err.IsClusterDown():
+	case err.IsLoading(), err.IsMasterDown(), err.IsReadOnly():
+		c.lazyRefresh()
+	default:
+		return false
+	}
+	backoff := maxRetryBackoff
+	if *attempts < 10 {
+		if b := minRetryBackoff << *attempts; b < backoff {
+			backoff = b
+		}
+	}
+	*attempts++
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
 func (c *ClusterClient) Dedicated(fn func(*DedicatedClusterClient) error) (err error) {
+	if !c.busy.enter() {
+		return ErrConnClosing
+	}
+	defer c.busy.leave()
 	dcc := &DedicatedClusterClient{Cmd: c.Cmd, client: c, slot: cmds.InitSlot}
 	err = fn(dcc)
 	dcc.release()
@@ -340,12 +791,45 @@ func (c *ClusterClient) NewHashRepository(prefix string, schema interface{}) *om
 	})
 }
 
-func (c *ClusterClient) Close() {
+// Close stops accepting new commands and waits for the in-flight commands, including Dedicated calls, to be answered.
+// Then it closes the connections to all nodes concurrently, including their blocking pools, and stops the PubSub hook.
+// If the ctx is done before the in-flight commands are answered or the connections are closed,
+// a *CloseError reporting the number of aborted commands is returned and the connections are closed in the background.
+func (c *ClusterClient) Close(ctx context.Context) error {
+	err := c.busy.drain(ctx)
+
 	c.mu.RLock()
+	wg := sync.WaitGroup{}
+	wg.Add(len(c.conns) + len(c.replicas))
 	for _, cc := range c.conns {
-		go cc.Close()
+		go func(cc conn) {
+			cc.Close()
+			wg.Done()
+		}(cc)
+	}
+	for _, cc := range c.replicas {
+		go func(cc conn) {
+			cc.Close()
+			wg.Done()
+		}(cc)
 	}
 	c.mu.RUnlock()
+
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return &CloseError{Err: ctx.Err()}
+	}
 }
 
 type DedicatedClusterClient struct {
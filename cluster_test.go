@@ -3,8 +3,12 @@ package rueidis
 import (
 	"context"
 	"errors"
+	"io"
 	"reflect"
+	"runtime"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -58,6 +62,18 @@ func TestClusterClientInit(t *testing.T) {
 		}
 	})
 
+	t.Run("Init with scheme", func(t *testing.T) {
+		v := errors.New("dial err")
+		if _, err := newClusterClient(ClusterClientOption{InitAddress: []string{"rediss://127.0.0.1:0"}}, func(dst string, opt ConnOption) conn {
+			if dst != "127.0.0.1:0" || opt.TLSConfig == nil {
+				t.Fatalf("unexpected dst %v and TLSConfig %v", dst, opt.TLSConfig)
+			}
+			return &MockConn{DialFn: func() error { return v }}
+		}); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
 	t.Run("Refresh err", func(t *testing.T) {
 		v := errors.New("refresh err")
 		if _, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
@@ -120,10 +136,262 @@ func TestClusterClientInit(t *testing.T) {
 	})
 }
 
+var shardsResp = proto.NewResult(proto.Message{Type: '*', Values: []proto.Message{
+	{Type: '%', Values: []proto.Message{
+		{Type: '+', String: "slots"},
+		{Type: '*', Values: []proto.Message{{Type: ':', Integer: 0}, {Type: ':', Integer: 8191}, {Type: ':', Integer: 8192}, {Type: ':', Integer: 16383}}},
+		{Type: '+', String: "nodes"},
+		{Type: '*', Values: []proto.Message{
+			{Type: '%', Values: []proto.Message{
+				{Type: '+', String: "id"}, {Type: '+', String: "r1"},
+				{Type: '+', String: "port"}, {Type: ':', Integer: 7001},
+				{Type: '+', String: "ip"}, {Type: '+', String: "::1"},
+				{Type: '+', String: "endpoint"}, {Type: '+', String: "::1"},
+				{Type: '+', String: "role"}, {Type: '+', String: "replica"},
+				{Type: '+', String: "health"}, {Type: '+', String: "loading"},
+			}},
+			{Type: '%', Values: []proto.Message{
+				{Type: '+', String: "id"}, {Type: '+', String: "m1"},
+				{Type: '+', String: "port"}, {Type: ':', Integer: 7000},
+				{Type: '+', String: "tls-port"}, {Type: ':', Integer: 8000},
+				{Type: '+', String: "ip"}, {Type: '+', String: "10.0.0.1"},
+				{Type: '+', String: "endpoint"}, {Type: '+', String: "?"},
+				{Type: '+', String: "role"}, {Type: '+', String: "master"},
+				{Type: '+', String: "health"}, {Type: '+', String: "online"},
+			}},
+		}},
+	}},
+	{Type: '%', Values: []proto.Message{ // a master without slots
+		{Type: '+', String: "slots"},
+		{Type: '*', Values: []proto.Message{}},
+		{Type: '+', String: "nodes"},
+		{Type: '*', Values: []proto.Message{
+			{Type: '%', Values: []proto.Message{
+				{Type: '+', String: "id"}, {Type: '+', String: "m2"},
+				{Type: '+', String: "port"}, {Type: ':', Integer: 7002},
+				{Type: '+', String: "endpoint"}, {Type: '+', String: "redis-2.local"},
+				{Type: '+', String: "role"}, {Type: '+', String: "master"},
+				{Type: '+', String: "health"}, {Type: '+', String: "online"},
+			}},
+		}},
+	}},
+}}, nil)
+
+func TestClusterClientTopology(t *testing.T) {
+	t.Run("CLUSTER SHARDS", func(t *testing.T) {
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				if strings.Join(cmd.Commands(), " ") == "CLUSTER SHARDS" {
+					return shardsResp
+				}
+				return proto.NewErrResult(errors.New("unexpected command"))
+			}}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if nodes := client.nodes(); len(nodes) != 1 || nodes[0] != "10.0.0.1:7000" {
+			t.Fatalf("unexpected nodes %v", nodes)
+		}
+		if nodes := client.Nodes(); !reflect.DeepEqual(nodes, []ClusterNode{
+			{ID: "m1", Addr: "10.0.0.1:7000", Role: "master", Health: "online"},
+			{ID: "r1", Addr: "[::1]:7001", Role: "replica", Health: "loading"},
+		}) {
+			t.Fatalf("unexpected nodes %v", nodes)
+		}
+	})
+
+	t.Run("CLUSTER SHARDS with TLS", func(t *testing.T) {
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"rediss://127.0.0.1:0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result { return shardsResp }}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if nodes := client.nodes(); len(nodes) != 1 || nodes[0] != "10.0.0.1:8000" {
+			t.Fatalf("unexpected nodes %v", nodes)
+		}
+	})
+
+	t.Run("CLUSTER SLOTS fallback", func(t *testing.T) {
+		var shards int64
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"[::1]:0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				if strings.Join(cmd.Commands(), " ") == "CLUSTER SHARDS" {
+					atomic.AddInt64(&shards, 1)
+					return proto.NewResult(proto.Message{Type: '-', String: "ERR unknown subcommand 'SHARDS'"}, nil)
+				}
+				return proto.NewResult(proto.Message{Type: '*', Values: []proto.Message{
+					{Type: '*', Values: []proto.Message{
+						{Type: ':', Integer: 0},
+						{Type: ':', Integer: 16383},
+						{Type: '*', Values: []proto.Message{ // master with unknown endpoint
+							{Type: '_'},
+							{Type: ':', Integer: 7000},
+							{Type: '+', String: "m1"},
+						}},
+						{Type: '*', Values: []proto.Message{ // replica with unset preferred hostname
+							{Type: '+', String: "?"},
+							{Type: ':', Integer: 7001},
+							{Type: '+', String: "r1"},
+							{Type: '%', Values: []proto.Message{{Type: '+', String: "ip"}, {Type: '+', String: "fe80::2"}}},
+						}},
+					}},
+				}}, nil)
+			}}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.refresh(); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if n := atomic.LoadInt64(&shards); n != 1 {
+			t.Fatalf("CLUSTER SHARDS should be tried only once, but got %v", n)
+		}
+		if nodes := client.Nodes(); !reflect.DeepEqual(nodes, []ClusterNode{
+			{ID: "m1", Addr: "[::1]:7000", Role: "master", Health: "online"},
+			{ID: "r1", Addr: "[fe80::2]:7001", Role: "replica", Health: "online"},
+		}) {
+			t.Fatalf("unexpected nodes %v", nodes)
+		}
+	})
+}
+
+func TestClusterClientAddressRemap(t *testing.T) {
+	var mu sync.Mutex
+	var dialed []string
+	remap := func(addr string) string {
+		return strings.Replace(addr, "10.0.0.1", "127.0.0.1", 1)
+	}
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:0"}, AddressRemap: remap}, func(dst string, opt ConnOption) conn {
+		mu.Lock()
+		dialed = append(dialed, dst)
+		mu.Unlock()
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] == "CLUSTER" {
+				return shardsResp
+			}
+			if dst == "127.0.0.1:7000" {
+				return proto.NewResult(proto.Message{Type: '-', String: "MOVED 0 10.0.0.1:7003"}, nil)
+			}
+			return proto.NewResult(proto.Message{Type: '+', String: dst}, nil)
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if nodes := client.nodes(); len(nodes) != 1 || nodes[0] != "127.0.0.1:7000" {
+		t.Fatalf("unexpected nodes %v", nodes)
+	}
+	if nodes := client.Nodes(); nodes[0].Addr != "127.0.0.1:7000" {
+		t.Fatalf("unexpected nodes %v", nodes)
+	}
+	if v, err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).ToString(); err != nil || v != "127.0.0.1:7003" {
+		t.Fatalf("unexpected resp %v %v", v, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for _, dst := range dialed {
+		if strings.HasPrefix(dst, "10.0.0.1") {
+			t.Fatalf("unexpected dialed address %v", dst)
+		}
+	}
+}
+
+func TestClusterClientRefresh(t *testing.T) {
+	t.Run("Refresh unchanged", func(t *testing.T) {
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result { return slotsResp }}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		conns := reflect.ValueOf(client.conns).Pointer()
+		if err := client.refresh(); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if reflect.ValueOf(client.conns).Pointer() != conns {
+			t.Fatalf("conns should not be swapped if the topology is unchanged")
+		}
+	})
+
+	t.Run("Refresh periodically", func(t *testing.T) {
+		var count int64
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}, RefreshInterval: time.Millisecond}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				atomic.AddInt64(&count, 1)
+				return slotsResp
+			}}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		for atomic.LoadInt64(&count) < 3 {
+			time.Sleep(time.Millisecond)
+		}
+		if err := client.Close(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		time.Sleep(10 * time.Millisecond)
+		closed := atomic.LoadInt64(&count)
+		time.Sleep(10 * time.Millisecond)
+		if atomic.LoadInt64(&count) != closed {
+			t.Fatalf("unexpected refresh after Close")
+		}
+	})
+
+	t.Run("Refresh on network error", func(t *testing.T) {
+		var count int64
+		v := errors.New("network err")
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					atomic.AddInt64(&count, 1)
+					return slotsResp
+				}
+				return proto.NewErrResult(v)
+			}}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).Error(); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+		for atomic.LoadInt64(&count) < 2 {
+			time.Sleep(time.Millisecond)
+		}
+	})
+
+	t.Run("No refresh after Close", func(t *testing.T) {
+		var count int64
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				atomic.AddInt64(&count, 1)
+				return slotsResp
+			}}
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.Close(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		closed := atomic.LoadInt64(&count)
+		client.lazyRefresh()
+		client.refreshOnNetworkErr(errors.New("network err"))
+		time.Sleep(10 * time.Millisecond)
+		if atomic.LoadInt64(&count) != closed {
+			t.Fatalf("unexpected refresh after Close")
+		}
+	})
+}
+
 func TestClusterClient(t *testing.T) {
 	m := &MockConn{
 		DoFn: func(cmd cmds.Completed) proto.Result {
-			if strings.Join(cmd.Commands(), " ") == "CLUSTER SLOTS" {
+			if cmd.Commands()[0] == "CLUSTER" {
 				return slotsResp
 			}
 			return proto.Result{}
@@ -176,13 +444,19 @@ func TestClusterClient(t *testing.T) {
 		}
 	})
 
-	t.Run("Delegate Close", func(t *testing.T) {
-		called := make(chan struct{})
-		m.CloseFn = func() {
-			close(called)
+	t.Run("Delegate DoStream", func(t *testing.T) {
+		c := client.Cmd.Get().Key("DoStream").Build()
+		m.DoStreamFn = func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+			if !reflect.DeepEqual(cmd.Commands(), c.Commands()) {
+				t.Fatalf("unexpected command %v", cmd)
+			}
+			n, err := io.WriteString(w, "DoStream")
+			return int64(n), err
+		}
+		buf := &strings.Builder{}
+		if n, err := client.DoStream(context.Background(), c).WriteTo(buf); err != nil || n != 8 || buf.String() != "DoStream" {
+			t.Fatalf("unexpected response %v %v %v", n, err, buf.String())
 		}
-		client.Close()
-		<-called
 	})
 
 	t.Run("Dedicated Err", func(t *testing.T) {
@@ -294,6 +568,32 @@ func TestClusterClient(t *testing.T) {
 			t.Fatalf("unexpected nil repo")
 		}
 	})
+
+	t.Run("Delegate Close", func(t *testing.T) {
+		called := make(chan struct{})
+		m.CloseFn = func() {
+			close(called)
+		}
+		if err := client.Close(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		<-called
+	})
+
+	t.Run("Reject after Close", func(t *testing.T) {
+		if err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).Error(); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.DoCache(context.Background(), client.Cmd.Get().Key("a").Cache(), 100).Error(); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if _, err := client.DoStream(context.Background(), client.Cmd.Get().Key("a").Build()).WriteTo(io.Discard); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.Dedicated(func(client *DedicatedClusterClient) error { return nil }); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
 }
 
 func TestClusterClientErr(t *testing.T) {
@@ -380,7 +680,7 @@ func TestClusterClientErr(t *testing.T) {
 	t.Run("slot moved", func(t *testing.T) {
 		count := 0
 		m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
-			if strings.Join(cmd.Commands(), " ") == "CLUSTER SLOTS" {
+			if cmd.Commands()[0] == "CLUSTER" {
 				return slotsResp
 			}
 			if count < 3 {
@@ -400,6 +700,76 @@ func TestClusterClientErr(t *testing.T) {
 		}
 	})
 
+	t.Run("slot moved (stream)", func(t *testing.T) {
+		count := 0
+		m := &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				return slotsResp
+			},
+			DoStreamFn: func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+				if count < 3 {
+					count++
+					return 0, &proto.RedisError{Type: '-', String: "MOVED 0 :1"}
+				}
+				n, err := io.WriteString(w, "b")
+				return int64(n), err
+			},
+		}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		buf := &strings.Builder{}
+		if n, err := client.DoStream(context.Background(), client.Cmd.Get().Key("a").Build()).WriteTo(buf); err != nil || n != 1 || buf.String() != "b" {
+			t.Fatalf("unexpected resp %v %v", n, err)
+		}
+	})
+
+	t.Run("slot asking (stream)", func(t *testing.T) {
+		var asked []string
+		stored := 0
+		w := &mock.Wire{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				asked = append(asked, cmd.Commands()...)
+				return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+			},
+			DoStreamFn: func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+				asked = append(asked, cmd.Commands()...)
+				n, err := io.WriteString(w, "b")
+				return int64(n), err
+			},
+		}
+		m := &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				return slotsResp
+			},
+			DoStreamFn: func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+				return 0, &proto.RedisError{Type: '-', String: "ASK 0 :1"}
+			},
+			AcquireFn: func() wire { return w },
+			StoreFn: func(ww wire) {
+				if ww == w {
+					stored++
+				}
+			},
+		}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		buf := &strings.Builder{}
+		if n, err := client.DoStream(context.Background(), client.Cmd.Get().Key("a").Build()).WriteTo(buf); err != nil || n != 1 || buf.String() != "b" {
+			t.Fatalf("unexpected resp %v %v", n, err)
+		}
+		if strings.Join(asked, " ") != "ASKING GET a" || stored != 1 {
+			t.Fatalf("unexpected commands on the pooled wire %v %v", asked, stored)
+		}
+	})
+
 	t.Run("slot moved (cache)", func(t *testing.T) {
 		count := 0
 		m := &MockConn{
@@ -429,7 +799,7 @@ func TestClusterClientErr(t *testing.T) {
 		count := 0
 		m := &MockConn{
 			DoFn: func(cmd cmds.Completed) proto.Result {
-				if strings.Join(cmd.Commands(), " ") == "CLUSTER SLOTS" {
+				if cmd.Commands()[0] == "CLUSTER" {
 					return slotsResp
 				}
 				return proto.NewResult(proto.Message{Type: '-', String: "ASK 0 :1"}, nil)
@@ -484,7 +854,7 @@ func TestClusterClientErr(t *testing.T) {
 	t.Run("slot try again", func(t *testing.T) {
 		count := 0
 		m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
-			if strings.Join(cmd.Commands(), " ") == "CLUSTER SLOTS" {
+			if cmd.Commands()[0] == "CLUSTER" {
 				return slotsResp
 			}
 			if count < 3 {
@@ -528,12 +898,157 @@ func TestClusterClientErr(t *testing.T) {
 			t.Fatalf("unexpected resp %v %v", v, err)
 		}
 	})
+
+	t.Run("too many redirects", func(t *testing.T) {
+		count := 0
+		m := &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					return slotsResp
+				}
+				count++
+				return proto.NewResult(proto.Message{Type: '-', String: "MOVED 0 :0"}, nil)
+			},
+			DoMultiFn: func(multi ...cmds.Completed) []proto.Result {
+				count++
+				return []proto.Result{{}, proto.NewResult(proto.Message{Type: '-', String: "ASK 0 :0"}, nil)}
+			},
+		}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}, MaxRedirects: 3}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		err = client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).Error()
+		var e *TooManyRedirectsError
+		if !errors.Is(err, ErrTooManyRedirects) || !errors.As(err, &e) || e.Redirects != 3 || e.Last != "MOVED 0 :0" || count != 4 {
+			t.Fatalf("unexpected err %v %v", err, count)
+		}
+		count = 0
+		m.DoFn = func(cmd cmds.Completed) proto.Result {
+			return slotsResp
+		}
+		m.DoCacheFn = func(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+			count++
+			return proto.NewResult(proto.Message{Type: '-', String: "ASK 0 :0"}, nil)
+		}
+		err = client.DoCache(context.Background(), client.Cmd.Get().Key("a").Cache(), 100).Error()
+		if !errors.As(err, &e) || e.Redirects != 3 || e.Last != "ASK 0 :0" || count != 4 {
+			t.Fatalf("unexpected err %v %v", err, count)
+		}
+	})
+
+	t.Run("slot cluster down with backoff", func(t *testing.T) {
+		count := 0
+		m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] == "CLUSTER" {
+				return slotsResp
+			}
+			if count < 4 {
+				count++
+				return proto.NewResult(proto.Message{Type: '-', String: "CLUSTERDOWN The cluster is down"}, nil)
+			}
+			return proto.NewResult(proto.Message{Type: '+', String: "b"}, nil)
+		}}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		start := time.Now()
+		if v, err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).ToString(); err != nil || v != "b" {
+			t.Fatalf("unexpected resp %v %v", v, err)
+		}
+		if elapsed := time.Since(start); elapsed < 15*time.Millisecond {
+			t.Fatalf("unexpected elapsed %v without backoff", elapsed)
+		}
+	})
+
+	t.Run("slot read only refreshes", func(t *testing.T) {
+		for _, msg := range []string{"READONLY You can't write against a read only replica.", "LOADING Redis is loading", "MASTERDOWN Link with MASTER is down"} {
+			var slots int64
+			count := 0
+			m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					atomic.AddInt64(&slots, 1)
+					return slotsResp
+				}
+				if count < 1 {
+					count++
+					return proto.NewResult(proto.Message{Type: '-', String: msg}, nil)
+				}
+				return proto.NewResult(proto.Message{Type: '+', String: "b"}, nil)
+			}}
+			client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+				return m
+			})
+			if err != nil {
+				t.Fatalf("unexpected err %v", err)
+			}
+			if v, err := client.Do(context.Background(), client.Cmd.Set().Key("a").Value("b").Build()).ToString(); err != nil || v != "b" {
+				t.Fatalf("unexpected resp %v %v", v, err)
+			}
+			for atomic.LoadInt64(&slots) != 2 {
+				runtime.Gosched()
+			}
+		}
+	})
+
+	t.Run("slot cluster down until max retries", func(t *testing.T) {
+		count := 0
+		m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] == "CLUSTER" {
+				return slotsResp
+			}
+			count++
+			return proto.NewResult(proto.Message{Type: '-', String: "CLUSTERDOWN The cluster is down"}, nil)
+		}}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}, MaxRetries: 3}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).Error(); err == nil || !err.(*proto.RedisError).IsClusterDown() || count != 4 {
+			t.Fatalf("unexpected err %v %v", err, count)
+		}
+	})
+
+	t.Run("slot try again until ctx done", func(t *testing.T) {
+		m := &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					return slotsResp
+				}
+				return proto.NewResult(proto.Message{Type: '-', String: "TRYAGAIN"}, nil)
+			},
+			DoCacheFn: func(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+				return proto.NewResult(proto.Message{Type: '-', String: "TRYAGAIN"}, nil)
+			},
+		}
+		client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+			return m
+		})
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if err := client.Do(ctx, client.Cmd.Get().Key("a").Build()).Error(); err == nil || !err.(*proto.RedisError).IsTryAgain() {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := client.DoCache(ctx, client.Cmd.Get().Key("a").Cache(), 100).Error(); err == nil || !err.(*proto.RedisError).IsTryAgain() {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
 }
 
 func TestHashObjectClusterClientAdapter(t *testing.T) {
 	m := &MockConn{
 		DoFn: func(cmd cmds.Completed) proto.Result {
-			if strings.Join(cmd.Commands(), " ") == "CLUSTER SLOTS" {
+			if cmd.Commands()[0] == "CLUSTER" {
 				return slotsResp
 			}
 			return proto.Result{}
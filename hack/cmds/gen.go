@@ -30,15 +30,90 @@ type Parameter struct {
 }
 
 type Command struct {
-	Group     string     `json:"group"`
-	Since     string     `json:"since"`
-	Arguments []Argument `json:"arguments"`
+	Group      string      `json:"group"`
+	Since      string      `json:"since"`
+	Complexity interface{} `json:"complexity"`
+	Arguments  []Argument  `json:"arguments"`
+	Module     string      `json:"-"`
+
+	// the following are in the Redis 7 format of the COMMAND DOCS and COMMAND INFO, and the flags are derived from them
+	DeprecatedSince string    `json:"deprecated_since"`
+	CommandFlags    []string  `json:"command_flags"`
+	CommandTips     []string  `json:"command_tips"`
+	KeySpecs        []KeySpec `json:"key_specs"`
+}
+
+type KeySpec struct {
+	Flags       []string `json:"flags"`
+	BeginSearch struct {
+		Index *struct {
+			Pos int `json:"pos"`
+		} `json:"index"`
+		Keyword *struct {
+			Keyword   string `json:"keyword"`
+			StartFrom int    `json:"startfrom"`
+		} `json:"keyword"`
+	} `json:"begin_search"`
+	FindKeys struct {
+		Range *struct {
+			LastKey int `json:"lastkey"`
+			Step    int `json:"step"`
+			Limit   int `json:"limit"`
+		} `json:"range"`
+		KeyNum *struct {
+			KeyNumIdx int `json:"keynumidx"`
+			FirstKey  int `json:"firstkey"`
+			Step      int `json:"step"`
+		} `json:"keynum"`
+	} `json:"find_keys"`
+}
+
+func (c Command) hasFlag(flag string) bool {
+	return contains(c.CommandFlags, flag)
+}
+
+func (c Command) hasTip(tip string) bool {
+	return contains(c.CommandTips, tip)
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyRange derives the positions of the keys from the key specs, like the first key, last key and step of the COMMAND INFO.
+// Only the adjacent specs of fixed positions are merged into the range. The keys are movable if there is any other spec.
+func (c Command) keyRange() (first, last, step int, movable bool) {
+	for _, spec := range c.KeySpecs {
+		index, rng := spec.BeginSearch.Index, spec.FindKeys.Range
+		if index == nil || rng == nil || rng.Limit > 1 {
+			movable = true
+			continue
+		}
+		end := rng.LastKey
+		if end >= 0 {
+			end += index.Pos
+		}
+		if first == 0 {
+			first, last, step = index.Pos, end, rng.Step
+		} else if last >= 0 && last+1 == index.Pos && step == 1 && rng.Step == 1 {
+			last = end
+		} else {
+			movable = true
+		}
+	}
+	return first, last, step, movable
 }
 
 type Argument struct {
 	Name     interface{} `json:"name"`
 	Type     interface{} `json:"type"`
 	Command  string      `json:"command"`
+	Token    string      `json:"token"`
 	Enum     []string    `json:"enum"`
 	Multiple bool        `json:"multiple"`
 	Optional bool        `json:"optional"`
@@ -280,14 +355,27 @@ func main() {
 		if err != nil {
 			panic(err)
 		}
-		if err := json.Unmarshal(raw, &commands); err != nil {
+		var cmds map[string]Command
+		if err := json.Unmarshal(raw, &cmds); err != nil {
 			panic(err)
 		}
+		module := strings.TrimSuffix(strings.TrimPrefix(p, "./commands_"), ".json")
+		for k, cmd := range cmds {
+			if p != "./commands.json" {
+				cmd.Module = module
+			}
+			commands[k] = cmd
+		}
 	}
 
-	// fis missing GEORADIUS_RO and GEORADIUSBYMEMBER_RO
-	commands["GEORADIUS_RO"] = Command{Arguments: filterArgs(commands["GEORADIUS"].Arguments, "STORE")}
-	commands["GEORADIUSBYMEMBER_RO"] = Command{Arguments: filterArgs(commands["GEORADIUSBYMEMBER"].Arguments, "STORE")}
+	// fix missing GEORADIUS_RO and GEORADIUSBYMEMBER_RO, which are the readonly variants without the STORE
+	for _, name := range []string{"GEORADIUS", "GEORADIUSBYMEMBER"} {
+		cmd := commands[name]
+		commands[name+"_RO"] = Command{
+			Group: cmd.Group, Since: "3.2.10", Complexity: cmd.Complexity, DeprecatedSince: cmd.DeprecatedSince,
+			Arguments: filterArgs(cmd.Arguments, "STORE"), CommandFlags: []string{"readonly"}, KeySpecs: cmd.KeySpecs[:1],
+		}
+	}
 
 	var roots []string
 	nodes := map[string]*Node{}
@@ -324,9 +412,49 @@ func main() {
 	defer tf.Close()
 
 	generate(gf, structs)
+	metas(gf, commands)
 	tests(tf, structs)
 }
 
+func metas(f io.Writer, commands map[string]Command) {
+	var names []string
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintf(f, "var metas = map[string]Meta{\n")
+	for _, name := range names {
+		cmd := commands[name]
+		fields := []string{fmt.Sprintf("Name: %q", name), fmt.Sprintf("Since: %q", cmd.Since), fmt.Sprintf("Group: %q", cmd.Group)}
+		if cmd.DeprecatedSince != "" {
+			fields = append(fields, fmt.Sprintf("DeprecatedSince: %q", cmd.DeprecatedSince))
+		}
+		first, last, step, movable := cmd.keyRange()
+		if first != 0 {
+			fields = append(fields, fmt.Sprintf("FirstKey: %d, LastKey: %d, KeyStep: %d", first, last, step))
+		}
+		if movable {
+			fields = append(fields, "MovableKeys: true")
+		}
+		if cmd.Module != "" {
+			fields = append(fields, fmt.Sprintf("Module: %q", cmd.Module))
+		}
+		switch c := cmd.Complexity.(type) {
+		case string:
+			fields = append(fields, fmt.Sprintf("Complexity: %q", c))
+		case []interface{}:
+			var lines []string
+			for _, l := range c {
+				lines = append(lines, l.(string))
+			}
+			fields = append(fields, fmt.Sprintf("Complexity: %q", strings.Join(lines, " ")))
+		}
+		fmt.Fprintf(f, "\t%q: {%s},\n", name, strings.Join(fields, ", "))
+	}
+	fmt.Fprintf(f, "}\n")
+}
+
 func tests(f io.Writer, structs map[string]GoStruct) {
 	var names []string
 	for name := range structs {
@@ -345,7 +473,7 @@ func tests(f io.Writer, structs map[string]GoStruct) {
 
 	fmt.Fprintf(f, "// Code generated DO NOT EDIT\n\npackage cmds\n\n")
 
-	fmt.Fprintf(f, "import \"testing\"\n\n")
+	fmt.Fprintf(f, "import (\n\t\"reflect\"\n\t\"testing\"\n)\n\n")
 
 	fmt.Fprintf(f, "var s = NewBuilder()\n")
 	fmt.Fprintf(f, "var c = NewSBuilder()\n\n")
@@ -356,7 +484,7 @@ func tests(f io.Writer, structs map[string]GoStruct) {
 		}
 		printPath(f, "s", p, "Build")
 		printPath(f, "c", p, "Build")
-		if within(p[0], cacheableCMDs) {
+		if rootFlags(p[0]).cacheable {
 			printPath(f, "s", p, "Cache")
 			printPath(f, "c", p, "Cache")
 		}
@@ -365,6 +493,37 @@ func tests(f io.Writer, structs map[string]GoStruct) {
 		}
 	}
 
+	fmt.Fprintf(f, "func TestCommandFlags(t *testing.T) {\n")
+	for _, name := range names {
+		s := structs[name]
+		if !s.Node.Root {
+			continue
+		}
+		tag := rootCf(s)
+		if tag == "" {
+			tag = "0"
+		}
+		for _, receiver := range []string{"s", "c"} {
+			fmt.Fprintf(f, "\tif v := %s.%s().cf; v != %s {\n", receiver, s.FullName, tag)
+			fmt.Fprintf(f, "\t\tt.Fatalf(\"unexpected flags of %s %%v\", v)\n", strings.Join(s.BuildDef.Command, " "))
+			fmt.Fprintf(f, "\t}\n")
+		}
+	}
+	fmt.Fprintf(f, "}\n\n")
+
+	fmt.Fprintf(f, "func TestBytesBuilders(t *testing.T) {\n")
+	for _, b := range bytesBuilders {
+		for _, prefix := range []string{"", "S"} {
+			method := b[1].BuildDef.MethodName
+			fmt.Fprintf(f, "\tif a, b := (%s%s{ks: InitSlot}).%sBytes(%s), (%s%s{ks: InitSlot}).%s(%s); !reflect.DeepEqual(a, b) {\n",
+				prefix, b[0].FullName, method, testBytesParams(b[1].BuildDef.Parameters),
+				prefix, b[0].FullName, method, testParams(b[1].BuildDef.Parameters))
+			fmt.Fprintf(f, "\t\tt.Fatalf(\"unexpected %s%s.%sBytes %%v\", a)\n", prefix, b[0].FullName, method)
+			fmt.Fprintf(f, "\t}\n")
+		}
+	}
+	fmt.Fprintf(f, "}\n\n")
+
 }
 
 func makePath(s GoStruct, path []GoStruct, pathes [][]GoStruct) [][]GoStruct {
@@ -409,6 +568,18 @@ func testParams(defs []Parameter) string {
 	return strings.Join(params, ", ")
 }
 
+func testBytesParams(defs []Parameter) string {
+	var params []string
+	for _, param := range defs {
+		if isBinaryParam(param) {
+			params = append(params, `[]byte("1")`)
+		} else {
+			params = append(params, testParams([]Parameter{param}))
+		}
+	}
+	return strings.Join(params, ", ")
+}
+
 func printPath(f io.Writer, receiver string, path []GoStruct, end string) {
 	fmt.Fprintf(f, "\t%s.%s()", receiver, path[0].BuildDef.MethodName)
 	for _, s := range path[1:] {
@@ -452,31 +623,23 @@ func generate(f io.Writer, structs map[string]GoStruct) {
 			for _, ss := range next.GoStructs() {
 				printBuilder(f, s, ss, "")
 				printBuilder(f, s, ss, "S")
+				if hasBinaryParam(s, ss) {
+					printBytesBuilder(f, s, ss, "")
+					printBytesBuilder(f, s, ss, "S")
+					bytesBuilders = append(bytesBuilders, [2]GoStruct{s, ss})
+				}
 			}
 		}
 
 		if allOptional(s.Node, s.NextNodes) {
 			printFinalBuilder(f, s, "", "Build", "Completed")
 			printFinalBuilder(f, s, "S", "Build", "SCompleted")
-			if within(s.Node.FindRoot().GoStructs()[0], cacheableCMDs) {
+			if rootFlags(s.Node.FindRoot().GoStructs()[0]).cacheable {
 				printFinalBuilder(f, s, "", "Cache", "Cacheable")
 				printFinalBuilder(f, s, "S", "Cache", "SCacheable")
 			}
 		}
 	}
-
-	checkAllUsed("noRetCMDs", noRetCMDs)
-	checkAllUsed("blockingCMDs", blockingCMDs)
-	checkAllUsed("cacheableCMDs", cacheableCMDs)
-	checkAllUsed("readOnlyCMDs", readOnlyCMDs)
-}
-
-func checkAllUsed(name string, tags map[string]bool) {
-	for t, v := range tags {
-		if !v {
-			panic(fmt.Sprintf("unsued tag %s in %s", t, name))
-		}
-	}
 }
 
 func allOptional(s *Node, nodes []*Node) bool {
@@ -517,6 +680,9 @@ func toGoName(paramName string) string {
 }
 
 func printRootBuilder(w io.Writer, root GoStruct, prefix string) {
+	if since := root.Node.Cmd.DeprecatedSince; since != "" {
+		fmt.Fprintf(w, "// Deprecated: since %s\n", since)
+	}
 	fmt.Fprintf(w, "func (b *%sBuilder) %s() %s%s {\n", prefix, root.FullName, prefix, root.FullName)
 
 	var appends []string
@@ -534,21 +700,23 @@ func printRootBuilder(w io.Writer, root GoStruct, prefix string) {
 }
 
 func rootCf(root GoStruct) (tag string) {
-	if within(root, blockingCMDs) {
+	flags := rootFlags(root)
+
+	if flags.blocking {
 		if tag != "" {
 			panic("root cf collision")
 		}
 		tag = "blockTag"
 	}
 
-	if within(root, noRetCMDs) {
+	if flags.noRet {
 		if tag != "" {
 			panic("root cf collision")
 		}
 		tag = "noRetTag"
 	}
 
-	if within(root, readOnlyCMDs) {
+	if flags.readonly {
 		if tag != "" {
 			panic("root cf collision")
 		}
@@ -558,6 +726,37 @@ func rootCf(root GoStruct) (tag string) {
 	return tag
 }
 
+type Flags struct {
+	readonly  bool
+	blocking  bool
+	noRet     bool
+	cacheable bool
+}
+
+// rootFlags derives the flags from the Redis 7 command metadata.
+func rootFlags(root GoStruct) (f Flags) {
+	cmd := root.Node.Cmd
+	f.readonly = cmd.hasFlag("readonly") && !cmd.hasFlag("write")
+	// the commands blocking only with the BLOCK argument, such as XREAD, are tagged by the argument instead
+	f.blocking = cmd.hasFlag("blocking") && !hasBlockArg(cmd.Arguments)
+	f.noRet = cmd.hasFlag("pubsub") && strings.HasSuffix(strings.Join(root.BuildDef.Command, " "), "SUBSCRIBE")
+	// the client side caching needs a deterministic reply of a single key right after the command name,
+	// which is also the cache key of the Cacheable.
+	first, last, _, movable := cmd.keyRange()
+	f.cacheable = f.readonly && !f.blocking && !cmd.hasTip("nondeterministic_output") &&
+		len(cmd.KeySpecs) == 1 && !movable && first == 1 && last == 1 && len(root.BuildDef.Command) == 1
+	return f
+}
+
+func hasBlockArg(args []Argument) bool {
+	for _, arg := range args {
+		if arg.Command == "BLOCK" || arg.Token == "BLOCK" || hasBlockArg(arg.Block) {
+			return true
+		}
+	}
+	return false
+}
+
 func printFinalBuilder(w io.Writer, parent GoStruct, prefix, method, ss string) {
 	fmt.Fprintf(w, "func (c %s%s) %s() %s {\n", prefix, parent.FullName, method, ss)
 	fmt.Fprintf(w, "\treturn %s(c)\n", ss)
@@ -694,12 +893,122 @@ func printBuilder(w io.Writer, parent, next GoStruct, prefix string) {
 	fmt.Fprintf(w, "}\n\n")
 }
 
+var bytesBuilders [][2]GoStruct
+
+// binaryParams are the names of the value-type parameters which also get a []byte variant of their builder
+var binaryParams = map[string]bool{
+	"value":           true,
+	"element":         true,
+	"member":          true,
+	"item":            true,
+	"arg":             true,
+	"message":         true,
+	"serializedValue": true,
+}
+
+func isBinaryParam(p Parameter) bool {
+	return binaryParams[p.Name] && toGoType(p.Type) == "string" && p.Type != "key"
+}
+
+func hasBinaryParam(parent, next GoStruct) bool {
+	if len(next.BuildDef.Parameters) != 1 && next.Variadic && parent.FullName != next.FullName {
+		return false // no parameter
+	}
+	binary := false
+	for _, p := range next.BuildDef.Parameters {
+		if toGoType(p.Type) == "[]string" {
+			return false
+		}
+		binary = binary || isBinaryParam(p)
+	}
+	return binary
+}
+
+// printBytesBuilder prints the XxxBytes variant of the builder, which takes []byte for the binary params
+// and converts them into strings without copying. It is only called when the hasBinaryParam is true.
+func printBytesBuilder(w io.Writer, parent, next GoStruct, prefix string) {
+	params := next.BuildDef.Parameters
+	variadic := len(params) == 1 && next.Variadic
+
+	fmt.Fprintf(w, "func (c %s%s) %sBytes(", prefix, parent.FullName, next.BuildDef.MethodName)
+	for i, param := range params {
+		typ := toGoType(param.Type)
+		if isBinaryParam(param) {
+			typ = "[]byte"
+		}
+		if variadic {
+			typ = "..." + typ
+		}
+		fmt.Fprintf(w, "%s %s", toGoName(param.Name), typ)
+		if i != len(params)-1 {
+			fmt.Fprintf(w, ", ")
+		}
+	}
+	fmt.Fprintf(w, ") %s%s {\n", prefix, next.FullName)
+
+	if prefix == "S" && !variadic {
+		for _, arg := range params {
+			if arg.Type == "key" {
+				fmt.Fprintf(w, "\tc.ks = checkSlot(c.ks, slot(%s))\n", toGoName(arg.Name))
+			}
+		}
+	}
+
+	for _, cmd := range next.BuildDef.Command {
+		if cmd == "BLOCK" {
+			fmt.Fprintf(w, "\tc.cf = blockTag\n")
+			break
+		}
+	}
+
+	var appends []string
+	for _, cmd := range next.BuildDef.Command {
+		if cmd == `""` {
+			appends = append(appends, `""`)
+		} else if !(len(params) != 1 && next.Variadic && parent.FullName == next.FullName) {
+			appends = append(appends, fmt.Sprintf(`"%s"`, cmd))
+		}
+	}
+	if variadic {
+		if len(appends) != 0 {
+			fmt.Fprintf(w, "\tc.cs = append(c.cs, %s)\n", strings.Join(appends, ", "))
+		}
+		fmt.Fprintf(w, "\tfor _, n := range %s {\n", toGoName(params[0].Name))
+		fmt.Fprintf(w, "\t\tc.cs = append(c.cs, bstr(n))\n")
+		fmt.Fprintf(w, "\t}\n")
+	} else {
+		for _, p := range params {
+			switch {
+			case isBinaryParam(p):
+				appends = append(appends, fmt.Sprintf("bstr(%s)", toGoName(p.Name)))
+			case toGoType(p.Type) == "float64":
+				appends = append(appends, fmt.Sprintf("strconv.FormatFloat(%s, 'f', -1, 64)", toGoName(p.Name)))
+			case toGoType(p.Type) == "int64":
+				appends = append(appends, fmt.Sprintf("strconv.FormatInt(%s, 10)", toGoName(p.Name)))
+			default:
+				appends = append(appends, toGoName(p.Name))
+			}
+		}
+		fmt.Fprintf(w, "\tc.cs = append(c.cs, %s)\n", strings.Join(appends, ", "))
+	}
+
+	if parent.FullName == next.FullName {
+		fmt.Fprintf(w, "\treturn c\n")
+	} else {
+		fmt.Fprintf(w, "\treturn (%s%s)(c)\n", prefix, next.FullName)
+	}
+	fmt.Fprintf(w, "}\n\n")
+}
+
 func makeChildNodes(parent *Node, args []Argument) (first *Node) {
 	if len(args) == 0 {
 		return nil
 	}
 	var nodes []*Node
 	for _, arg := range args {
+		if arg.Command == "" {
+			arg.Command = arg.Token // the Redis 7 format
+		}
 		nodes = append(nodes, &Node{Parent: parent, Arg: arg})
 	}
 	for i, node := range nodes {
@@ -760,237 +1069,3 @@ func LcFirst(str string) string {
 	}
 	return ""
 }
-
-func within(cmd GoStruct, cmds map[string]bool) bool {
-	n := strings.ToLower(cmd.FullName)
-	if _, ok := cmds[n]; ok {
-		cmds[n] = true
-		return true
-	}
-	return false
-}
-
-var noRetCMDs = map[string]bool{
-	"subscribe":    false,
-	"psubscribe":   false,
-	"unsubscribe":  false,
-	"punsubscribe": false,
-}
-
-var blockingCMDs = map[string]bool{
-	"blpop":       false,
-	"brpop":       false,
-	"brpoplpush":  false,
-	"blmove":      false,
-	"blmpop":      false,
-	"bzpopmin":    false,
-	"bzpopmax":    false,
-	"bzmpop":      false,
-	"clientpause": false,
-	"migrate":     false,
-	"wait":        false,
-}
-
-var cacheableCMDs = map[string]bool{
-	"bitcount":            false,
-	"bitfieldro":          false,
-	"bitpos":              false,
-	"expiretime":          false,
-	"geodist":             false,
-	"geohash":             false,
-	"geopos":              false,
-	"georadiusro":         false,
-	"georadiusbymemberro": false,
-	"geosearch":           false,
-	"get":                 false,
-	"getbit":              false,
-	"getrange":            false,
-	"hexists":             false,
-	"hget":                false,
-	"hgetall":             false,
-	"hkeys":               false,
-	"hlen":                false,
-	"hmget":               false,
-	"hstrlen":             false,
-	"hvals":               false,
-	"lindex":              false,
-	"llen":                false,
-	"lpos":                false,
-	"lrange":              false,
-	"pexpiretime":         false,
-	"pttl":                false,
-	"scard":               false,
-	"sismember":           false,
-	"smembers":            false,
-	"smismember":          false,
-	"sortro":              false,
-	"strlen":              false,
-	"ttl":                 false,
-	"type":                false,
-	"zcard":               false,
-	"zcount":              false,
-	"zlexcount":           false,
-	"zmscore":             false,
-	"zrange":              false,
-	"zrangebylex":         false,
-	"zrangebyscore":       false,
-	"zrank":               false,
-	"zrevrange":           false,
-	"zrevrangebylex":      false,
-	"zrevrangebyscore":    false,
-	"zrevrank":            false,
-	"zscore":              false,
-	"jsonget":             false,
-	"jsonstrlen":          false,
-	"jsonarrindex":        false,
-	"jsonarrlen":          false,
-	"jsonobjkeys":         false,
-	"jsonobjlen":          false,
-	"jsontype":            false,
-	"jsonresp":            false,
-	"bfexists":            false,
-	"bfinfo":              false,
-	"cfexists":            false,
-	"cfcount":             false,
-	"cfinfo":              false,
-	"cmsquery":            false,
-	"cmsinfo":             false,
-	"topkquery":           false,
-	"topklist":            false,
-	"topkinfo":            false,
-}
-
-var readOnlyCMDs = map[string]bool{
-	"bitcount":            false,
-	"bitfieldro":          false,
-	"bitpos":              false,
-	"dbsize":              false,
-	"dump":                false,
-	"evalro":              false,
-	"evalsharo":           false,
-	"exists":              false,
-	"expiretime":          false,
-	"geodist":             false,
-	"geohash":             false,
-	"geopos":              false,
-	"georadiusro":         false,
-	"georadiusbymemberro": false,
-	"geosearch":           false,
-	"get":                 false,
-	"getbit":              false,
-	"getrange":            false,
-	"hexists":             false,
-	"hget":                false,
-	"hgetall":             false,
-	"hkeys":               false,
-	"hlen":                false,
-	"hmget":               false,
-	"hrandfield":          false,
-	"hscan":               false,
-	"hstrlen":             false,
-	"hvals":               false,
-	"keys":                false,
-	"lindex":              false,
-	"llen":                false,
-	"lolwut":              false,
-	"lpos":                false,
-	"lrange":              false,
-	"memorydoctor":        false,
-	"memorystats":         false,
-	"memoryusage":         false,
-	"memorymallocstats":   false,
-	"mget":                false,
-	"objectencoding":      false,
-	"objectfreq":          false,
-	"objecthelp":          false,
-	"objectidletime":      false,
-	"objectrefcount":      false,
-	"pexpiretime":         false,
-	"pfcount":             false,
-	"pttl":                false,
-	"pubsubchannels":      false,
-	"pubsubnumpat":        false,
-	"pubsubnumsub":        false,
-	"pubsubhelp":          false,
-	"randomkey":           false,
-	"scan":                false,
-	"scard":               false,
-	"sdiff":               false,
-	"sinter":              false,
-	"sintercard":          false,
-	"sismember":           false,
-	"slowlogget":          false,
-	"slowloglen":          false,
-	"slowloghelp":         false,
-	"smembers":            false,
-	"smismember":          false,
-	"sortro":              false,
-	"srandmember":         false,
-	"sscan":               false,
-	"lcs":                 false,
-	"strlen":              false,
-	"sunion":              false,
-	"touch":               false,
-	"ttl":                 false,
-	"type":                false,
-	"xinfoconsumers":      false,
-	"xinfogroups":         false,
-	"xinfostream":         false,
-	"xinfohelp":           false,
-	"xlen":                false,
-	"xpending":            false,
-	"xrange":              false,
-	"xread":               false,
-	"xrevrange":           false,
-	"zcard":               false,
-	"zcount":              false,
-	"zdiff":               false,
-	"zinter":              false,
-	"zlexcount":           false,
-	"zmscore":             false,
-	"zrandmember":         false,
-	"zrange":              false,
-	"zrangebylex":         false,
-	"zrangebyscore":       false,
-	"zrank":               false,
-	"zrevrange":           false,
-	"zrevrangebylex":      false,
-	"zrevrangebyscore":    false,
-	"zrevrank":            false,
-	"zscan":               false,
-	"zscore":              false,
-	"zunion":              false,
-	"zintercard":          false,
-	"jsonget":             false,
-	"jsonmget":            false,
-	"jsonstrlen":          false,
-	"jsonarrindex":        false,
-	"jsonarrlen":          false,
-	"jsonobjkeys":         false,
-	"jsonobjlen":          false,
-	"jsontype":            false,
-	"jsonresp":            false,
-	"bfexists":            false,
-	"bfmexists":           false,
-	"bfscandump":          false,
-	"bfinfo":              false,
-	"cfexists":            false,
-	"cfcount":             false,
-	"cfscandump":          false,
-	"cfinfo":              false,
-	"cmsquery":            false,
-	"cmsinfo":             false,
-	"topkquery":           false,
-	"topklist":            false,
-	"topkinfo":            false,
-	"tsrange":             false,
-	"tsrevrange":          false,
-	"tsget":               false,
-	"tsinfo":              false,
-	"tsqueryindex":        false,
-	"graphroquery":        false,
-	"graphexplain":        false,
-	"graphslowlog":        false,
-	"graphconfigget":      false,
-	"graphlist":           false,
-}
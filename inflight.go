@@ -0,0 +1,66 @@
+package rueidis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+const closingOffset = -(1 << 62)
+
+// CloseError is returned by Close if the ctx is done before all in-flight commands are answered.
+type CloseError struct {
+	// Aborted is the number of in-flight calls that were not finished before the ctx is done.
+	Aborted int64
+	Err     error
+}
+
+func (e *CloseError) Error() string {
+	return fmt.Sprintf("rueidis: %d in-flight commands aborted: %v", e.Aborted, e.Err)
+}
+
+func (e *CloseError) Unwrap() error {
+	return e.Err
+}
+
+func newInflight() *inflight {
+	return &inflight{done: make(chan struct{})}
+}
+
+// inflight counts the calls in progress and rejects new calls once it starts draining.
+// The counter is shifted by closingOffset when draining, so the fast path needs only one atomic add.
+type inflight struct {
+	n     int64
+	done  chan struct{}
+	once  sync.Once
+	close sync.Once
+}
+
+func (f *inflight) enter() bool {
+	if atomic.AddInt64(&f.n, 1) < 0 {
+		f.leave()
+		return false
+	}
+	return true
+}
+
+func (f *inflight) leave() {
+	if atomic.AddInt64(&f.n, -1) == closingOffset {
+		f.close.Do(func() { close(f.done) })
+	}
+}
+
+func (f *inflight) drain(ctx context.Context) error {
+	f.once.Do(func() {
+		if atomic.AddInt64(&f.n, closingOffset) == closingOffset {
+			f.close.Do(func() { close(f.done) })
+		}
+	})
+	select {
+	case <-f.done:
+		return nil
+	case <-ctx.Done():
+		return &CloseError{Aborted: atomic.LoadInt64(&f.n) - closingOffset, Err: ctx.Err()}
+	}
+}
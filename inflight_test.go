@@ -0,0 +1,78 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func TestInflight(t *testing.T) {
+	t.Run("drain without calls", func(t *testing.T) {
+		f := newInflight()
+		if err := f.drain(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if f.enter() {
+			t.Fatalf("enter should be rejected after drain")
+		}
+		if err := f.drain(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+	t.Run("drain waits for calls", func(t *testing.T) {
+		f := newInflight()
+		if !f.enter() {
+			t.Fatalf("enter should be accepted")
+		}
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			f.leave()
+		}()
+		if err := f.drain(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+	t.Run("drain reports aborted calls", func(t *testing.T) {
+		f := newInflight()
+		f.enter()
+		f.enter()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		var ce *CloseError
+		if err := f.drain(ctx); !errors.As(err, &ce) || ce.Aborted != 2 || !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("unexpected err %v", err)
+		}
+		f.leave()
+		f.leave()
+		if err := f.drain(context.Background()); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+}
+
+func TestSingleClientCloseTimeout(t *testing.T) {
+	blocked := make(chan struct{})
+	release := make(chan struct{})
+	m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+		close(blocked)
+		<-release
+		return proto.Result{}
+	}}
+	client, err := newSingleClient(SingleClientOption{}, func(dst string, opt ConnOption) conn { return m })
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	go client.Do(context.Background(), client.Cmd.Get().Key("a").Build())
+	<-blocked
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	var ce *CloseError
+	if err := client.Close(ctx); !errors.As(err, &ce) || ce.Aborted != 1 {
+		t.Fatalf("unexpected err %v", err)
+	}
+	close(release)
+}
@@ -0,0 +1,114 @@
+package cmds
+
+// Arbitrary builds a command which is not generated from the command specs, such as the ones of custom modules.
+// The command is treated as a write command unless it is completed by the ReadOnly, Blocking or Cache.
+type Arbitrary Completed
+
+// SArbitrary is the Arbitrary of the SBuilder, whose slot is calculated from the keys passed to the Keys.
+type SArbitrary SCompleted
+
+// Arbitrary starts a command with the given tokens, ex. Arbitrary("MODULE.CMD") or Arbitrary("CONFIG", "GET").
+func (b *Builder) Arbitrary(token ...string) Arbitrary {
+	return Arbitrary{cs: append(b.get(), token...), ks: InitSlot}
+}
+
+// Arbitrary starts a command with the given tokens, ex. Arbitrary("MODULE.CMD") or Arbitrary("CONFIG", "GET").
+func (b *SBuilder) Arbitrary(token ...string) SArbitrary {
+	return SArbitrary{cs: append(b.get(), token...), ks: InitSlot}
+}
+
+// Keys appends the keys of the command.
+func (c Arbitrary) Keys(keys ...string) Arbitrary {
+	c.cf = cacheKeyFlag(c.cf, len(c.cs), len(keys))
+	c.cs = append(c.cs, keys...)
+	return c
+}
+
+// Keys appends the keys of the command, and panics if they belong to different slots.
+func (c SArbitrary) Keys(keys ...string) SArbitrary {
+	for _, k := range keys {
+		c.ks = checkSlot(c.ks, slot(k))
+	}
+	c.cf = cacheKeyFlag(c.cf, len(c.cs), len(keys))
+	c.cs = append(c.cs, keys...)
+	return c
+}
+
+// cacheKeyFlag marks the command cacheable only if it has exactly one key right after a single command token.
+func cacheKeyFlag(cf uint16, tokens, keys int) uint16 {
+	if tokens == 1 && keys == 1 {
+		return cf | cacheKey
+	}
+	return cf &^ cacheKey
+}
+
+// Args appends the arguments which are not keys.
+func (c Arbitrary) Args(args ...string) Arbitrary {
+	c.cs = append(c.cs, args...)
+	return c
+}
+
+// Args appends the arguments which are not keys.
+func (c SArbitrary) Args(args ...string) SArbitrary {
+	c.cs = append(c.cs, args...)
+	return c
+}
+
+func (c Arbitrary) Build() Completed {
+	c.cf &^= cacheKey
+	return Completed(c)
+}
+
+func (c SArbitrary) Build() SCompleted {
+	c.cf &^= cacheKey
+	return SCompleted(c)
+}
+
+// ReadOnly completes a read only command, which can be retried on network errors.
+func (c Arbitrary) ReadOnly() Completed {
+	c.cf = readonly
+	return Completed(c)
+}
+
+// ReadOnly completes a read only command, which can be retried on network errors.
+func (c SArbitrary) ReadOnly() SCompleted {
+	c.cf = readonly
+	return SCompleted(c)
+}
+
+// Blocking completes a blocking command, which is sent through the blocking pool instead of the pipeline.
+func (c Arbitrary) Blocking() Completed {
+	c.cf = blockTag
+	return Completed(c)
+}
+
+// Blocking completes a blocking command, which is sent through the blocking pool instead of the pipeline.
+func (c SArbitrary) Blocking() SCompleted {
+	c.cf = blockTag
+	return SCompleted(c)
+}
+
+// Cache completes a read only command for the client side caching.
+// The command must be in the form of a single token followed by a single key, ex. Arbitrary("MODULE.GET").Keys("k").Args(...),
+// because the second element of the command is used as the tracked key. It panics otherwise.
+func (c Arbitrary) Cache() Cacheable {
+	if c.cf&cacheKey != cacheKey {
+		panic(arbitraryNoKeyErr)
+	}
+	c.cf = readonly
+	return Cacheable(c)
+}
+
+// Cache completes a read only command for the client side caching. See Arbitrary.Cache for the requirement.
+func (c SArbitrary) Cache() SCacheable {
+	if c.cf&cacheKey != cacheKey {
+		panic(arbitraryNoKeyErr)
+	}
+	c.cf = readonly
+	return SCacheable(c)
+}
+
+// cacheKey is only set on an Arbitrary being built, and it is cleared when the Arbitrary is completed.
+const cacheKey = uint16(1 << 11)
+
+const arbitraryNoKeyErr = "arbitrary command without a single key after a single token can't be cached"
@@ -0,0 +1,91 @@
+package cmds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestArbitrary(t *testing.T) {
+	b := NewBuilder()
+	c := b.Arbitrary("MODULE.CMD", "SUB").Keys("a", "b").Args("1", "2").Build()
+	if !reflect.DeepEqual(c.Commands(), []string{"MODULE.CMD", "SUB", "a", "b", "1", "2"}) {
+		t.Fatalf("unexpected commands %v", c.Commands())
+	}
+	if !c.IsWrite() || c.IsBlock() {
+		t.Fatalf("unexpected flags %v", c.cf)
+	}
+	if c := b.Arbitrary("MODULE.GET").Keys("a").ReadOnly(); !c.IsReadOnly() {
+		t.Fatalf("unexpected flags %v", c.cf)
+	}
+	if c := b.Arbitrary("MODULE.BPOP").Keys("a").Args("0").Blocking(); !c.IsBlock() {
+		t.Fatalf("unexpected flags %v", c.cf)
+	}
+	cc := b.Arbitrary("MODULE.GET").Keys("a").Args("f").Cache()
+	if key, cmd := cc.CacheKey(); key != "a" || cmd != "MODULE.GETf" {
+		t.Fatalf("unexpected cache key %v %v", key, cmd)
+	}
+	if c := b.Arbitrary("MODULE.GET").Keys("a").Build(); c.cf != 0 {
+		t.Fatalf("unexpected flags %v", c.cf)
+	}
+	for name, fn := range map[string]func(){
+		"no key":            func() { b.Arbitrary("CONFIG", "GET").Cache() },
+		"args only":         func() { b.Arbitrary("MODULE.GET").Args("a").Cache() },
+		"multiple tokens":   func() { b.Arbitrary("MODULE", "GET").Keys("a").Cache() },
+		"multiple keys":     func() { b.Arbitrary("MODULE.GET").Keys("a", "b").Cache() },
+		"keys called twice": func() { b.Arbitrary("MODULE.GET").Keys("a").Keys("b").Cache() },
+		"keys after args":   func() { b.Arbitrary("MODULE.GET").Args("a").Keys("b").Cache() },
+	} {
+		t.Run("cache "+name, func(t *testing.T) {
+			defer func() {
+				if v := recover(); v != arbitraryNoKeyErr {
+					t.Fatalf("unexpected recover %v", v)
+				}
+			}()
+			fn()
+		})
+	}
+}
+
+func TestSArbitrary(t *testing.T) {
+	b := NewSBuilder()
+	c := b.Arbitrary("MODULE.CMD").Keys("{a}1", "{a}2").Args("1").Build()
+	if !reflect.DeepEqual(c.Commands(), []string{"MODULE.CMD", "{a}1", "{a}2", "1"}) {
+		t.Fatalf("unexpected commands %v", c.Commands())
+	}
+	if c.Slot() != slot("a") {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Arbitrary("MODULE.INFO").ReadOnly(); c.Slot() != InitSlot || c.cf != readonly {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Arbitrary("MODULE.BPOP").Keys("a").Blocking(); c.Slot() != slot("a") || c.cf != blockTag {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Arbitrary("MODULE.GET").Keys("a").Cache(); c.Slot() != slot("a") {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	t.Run("cross slot", func(t *testing.T) {
+		defer func() {
+			if v := recover(); v != multiKeySlotErr {
+				t.Fatalf("unexpected recover %v", v)
+			}
+		}()
+		b.Arbitrary("MODULE.CMD").Keys("a", "b")
+	})
+	t.Run("cache without key", func(t *testing.T) {
+		defer func() {
+			if v := recover(); v != arbitraryNoKeyErr {
+				t.Fatalf("unexpected recover %v", v)
+			}
+		}()
+		b.Arbitrary("MODULE.CMD").Args("a").Cache()
+	})
+	t.Run("cache after multiple tokens", func(t *testing.T) {
+		defer func() {
+			if v := recover(); v != arbitraryNoKeyErr {
+				t.Fatalf("unexpected recover %v", v)
+			}
+		}()
+		b.Arbitrary("MODULE", "GET").Keys("a").Cache()
+	})
+}
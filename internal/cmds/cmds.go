@@ -1,6 +1,9 @@
 package cmds
 
-import "strings"
+import (
+	"strings"
+	"unsafe"
+)
 
 const (
 	optInTag = uint16(1 << 15)
@@ -8,6 +11,9 @@ const (
 	noRetTag = uint16(1 << 13)
 	readonly = uint16(1 << 12)
 	InitSlot = uint16(1 << 15)
+	// MultiSlot is the slot of the commands built by the SBuilder.Split() with keys in different slots.
+	MultiSlot = ^uint16(0)
+	splitTag  = uint16(1 << 14)
 )
 
 var (
@@ -24,6 +30,9 @@ var (
 	SlotCmd = Completed{
 		cs: []string{"CLUSTER", "SLOTS"},
 	}
+	ShardsCmd = Completed{
+		cs: []string{"CLUSTER", "SHARDS"},
+	}
 	AskingCmd = Completed{
 		cs: []string{"ASKING"},
 	}
@@ -71,13 +80,13 @@ func (c *SCompleted) Commands() []string {
 }
 
 func (c *SCompleted) Slot() uint16 {
-	return c.ks
+	return untagSlot(c.ks)
 }
 
 type SCacheable Completed
 
 func (c *SCacheable) Slot() uint16 {
-	return c.ks
+	return untagSlot(c.ks)
 }
 
 func (c *SCacheable) Commands() []string {
@@ -136,7 +145,27 @@ func checkSlot(prev, new uint16) uint16 {
 	if prev == InitSlot || prev == new {
 		return new
 	}
+	if prev&splitTag == splitTag {
+		// the commands built by the SBuilder.Split() keep the splitTag, and become MultiSlot once the slots differ
+		if prev == InitSlot|splitTag || prev == new|splitTag {
+			return new | splitTag
+		}
+		return MultiSlot
+	}
 	panic(multiKeySlotErr)
 }
 
+func untagSlot(ks uint16) uint16 {
+	if ks&splitTag == splitTag && ks != MultiSlot {
+		return ks &^ splitTag
+	}
+	return ks
+}
+
 const multiKeySlotErr = "multi key command with different key slots are not allowed"
+
+// bstr converts the bs into a string without copying, used by the XxxBytes builders.
+// The bs must not be modified until the command is done.
+func bstr(bs []byte) string {
+	return *(*string)(unsafe.Pointer(&bs))
+}
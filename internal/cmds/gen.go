@@ -431,6 +431,16 @@ func (c SAppendKey) Value(value string) SAppendValue {
 	return (SAppendValue)(c)
 }
 
+func (c AppendKey) ValueBytes(value []byte) AppendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (AppendValue)(c)
+}
+
+func (c SAppendKey) ValueBytes(value []byte) SAppendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SAppendValue)(c)
+}
+
 type AppendValue Completed
 
 type SAppendValue SCompleted
@@ -570,6 +580,16 @@ func (c SBfAddKey) Item(item string) SBfAddItem {
 	return (SBfAddItem)(c)
 }
 
+func (c BfAddKey) ItemBytes(item []byte) BfAddItem {
+	c.cs = append(c.cs, bstr(item))
+	return (BfAddItem)(c)
+}
+
+func (c SBfAddKey) ItemBytes(item []byte) SBfAddItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SBfAddItem)(c)
+}
+
 type BfExists Completed
 
 type SBfExists SCompleted
@@ -627,6 +647,16 @@ func (c SBfExistsKey) Item(item string) SBfExistsItem {
 	return (SBfExistsItem)(c)
 }
 
+func (c BfExistsKey) ItemBytes(item []byte) BfExistsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (BfExistsItem)(c)
+}
+
+func (c SBfExistsKey) ItemBytes(item []byte) SBfExistsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SBfExistsItem)(c)
+}
+
 type BfInfo Completed
 
 type SBfInfo SCompleted
@@ -839,6 +869,20 @@ func (c SBfInsertItem) Item(item ...string) SBfInsertItem {
 	return c
 }
 
+func (c BfInsertItem) ItemBytes(item ...[]byte) BfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SBfInsertItem) ItemBytes(item ...[]byte) SBfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c BfInsertItem) Build() Completed {
 	return Completed(c)
 }
@@ -861,6 +905,20 @@ func (c SBfInsertItems) Item(item ...string) SBfInsertItem {
 	return (SBfInsertItem)(c)
 }
 
+func (c BfInsertItems) ItemBytes(item ...[]byte) BfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (BfInsertItem)(c)
+}
+
+func (c SBfInsertItems) ItemBytes(item ...[]byte) SBfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SBfInsertItem)(c)
+}
+
 type BfInsertKey Completed
 
 type SBfInsertKey SCompleted
@@ -1063,6 +1121,20 @@ func (c SBfMaddItem) Item(item ...string) SBfMaddItem {
 	return c
 }
 
+func (c BfMaddItem) ItemBytes(item ...[]byte) BfMaddItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SBfMaddItem) ItemBytes(item ...[]byte) SBfMaddItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c BfMaddItem) Build() Completed {
 	return Completed(c)
 }
@@ -1085,6 +1157,20 @@ func (c SBfMaddKey) Item(item ...string) SBfMaddItem {
 	return (SBfMaddItem)(c)
 }
 
+func (c BfMaddKey) ItemBytes(item ...[]byte) BfMaddItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (BfMaddItem)(c)
+}
+
+func (c SBfMaddKey) ItemBytes(item ...[]byte) SBfMaddItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SBfMaddItem)(c)
+}
+
 type BfMexists Completed
 
 type SBfMexists SCompleted
@@ -1122,6 +1208,20 @@ func (c SBfMexistsItem) Item(item ...string) SBfMexistsItem {
 	return c
 }
 
+func (c BfMexistsItem) ItemBytes(item ...[]byte) BfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SBfMexistsItem) ItemBytes(item ...[]byte) SBfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c BfMexistsItem) Build() Completed {
 	return Completed(c)
 }
@@ -1130,6 +1230,14 @@ func (c SBfMexistsItem) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c BfMexistsItem) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SBfMexistsItem) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type BfMexistsKey Completed
 
 type SBfMexistsKey SCompleted
@@ -1144,6 +1252,20 @@ func (c SBfMexistsKey) Item(item ...string) SBfMexistsItem {
 	return (SBfMexistsItem)(c)
 }
 
+func (c BfMexistsKey) ItemBytes(item ...[]byte) BfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (BfMexistsItem)(c)
+}
+
+func (c SBfMexistsKey) ItemBytes(item ...[]byte) SBfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SBfMexistsItem)(c)
+}
+
 type BfReserve Completed
 
 type SBfReserve SCompleted
@@ -1296,6 +1418,14 @@ func (c SBfScandumpIterator) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c BfScandumpIterator) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SBfScandumpIterator) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type BfScandumpKey Completed
 
 type SBfScandumpKey SCompleted
@@ -2547,10 +2677,12 @@ type Brpoplpush Completed
 
 type SBrpoplpush SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Brpoplpush() Brpoplpush {
 	return Brpoplpush{cs: append(b.get(), "BRPOPLPUSH"), ks: InitSlot, cf: blockTag}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Brpoplpush() SBrpoplpush {
 	return SBrpoplpush{cs: append(b.get(), "BRPOPLPUSH"), ks: InitSlot, cf: blockTag}
 }
@@ -2930,6 +3062,16 @@ func (c SCfAddKey) Item(item string) SCfAddItem {
 	return (SCfAddItem)(c)
 }
 
+func (c CfAddKey) ItemBytes(item []byte) CfAddItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CfAddItem)(c)
+}
+
+func (c SCfAddKey) ItemBytes(item []byte) SCfAddItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCfAddItem)(c)
+}
+
 type CfAddnx Completed
 
 type SCfAddnx SCompleted
@@ -2967,6 +3109,20 @@ func (c SCfAddnxItem) Item(item ...string) SCfAddnxItem {
 	return c
 }
 
+func (c CfAddnxItem) ItemBytes(item ...[]byte) CfAddnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SCfAddnxItem) ItemBytes(item ...[]byte) SCfAddnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c CfAddnxItem) Build() Completed {
 	return Completed(c)
 }
@@ -2989,6 +3145,20 @@ func (c SCfAddnxKey) Item(item ...string) SCfAddnxItem {
 	return (SCfAddnxItem)(c)
 }
 
+func (c CfAddnxKey) ItemBytes(item ...[]byte) CfAddnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (CfAddnxItem)(c)
+}
+
+func (c SCfAddnxKey) ItemBytes(item ...[]byte) SCfAddnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SCfAddnxItem)(c)
+}
+
 type CfCount Completed
 
 type SCfCount SCompleted
@@ -3046,6 +3216,16 @@ func (c SCfCountKey) Item(item string) SCfCountItem {
 	return (SCfCountItem)(c)
 }
 
+func (c CfCountKey) ItemBytes(item []byte) CfCountItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CfCountItem)(c)
+}
+
+func (c SCfCountKey) ItemBytes(item []byte) SCfCountItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCfCountItem)(c)
+}
+
 type CfDel Completed
 
 type SCfDel SCompleted
@@ -3095,6 +3275,16 @@ func (c SCfDelKey) Item(item string) SCfDelItem {
 	return (SCfDelItem)(c)
 }
 
+func (c CfDelKey) ItemBytes(item []byte) CfDelItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CfDelItem)(c)
+}
+
+func (c SCfDelKey) ItemBytes(item []byte) SCfDelItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCfDelItem)(c)
+}
+
 type CfExists Completed
 
 type SCfExists SCompleted
@@ -3152,6 +3342,16 @@ func (c SCfExistsKey) Item(item string) SCfExistsItem {
 	return (SCfExistsItem)(c)
 }
 
+func (c CfExistsKey) ItemBytes(item []byte) CfExistsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CfExistsItem)(c)
+}
+
+func (c SCfExistsKey) ItemBytes(item []byte) SCfExistsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCfExistsItem)(c)
+}
+
 type CfInfo Completed
 
 type SCfInfo SCompleted
@@ -3256,6 +3456,20 @@ func (c SCfInsertItem) Item(item ...string) SCfInsertItem {
 	return c
 }
 
+func (c CfInsertItem) ItemBytes(item ...[]byte) CfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SCfInsertItem) ItemBytes(item ...[]byte) SCfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c CfInsertItem) Build() Completed {
 	return Completed(c)
 }
@@ -3278,6 +3492,20 @@ func (c SCfInsertItems) Item(item ...string) SCfInsertItem {
 	return (SCfInsertItem)(c)
 }
 
+func (c CfInsertItems) ItemBytes(item ...[]byte) CfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (CfInsertItem)(c)
+}
+
+func (c SCfInsertItems) ItemBytes(item ...[]byte) SCfInsertItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SCfInsertItem)(c)
+}
+
 type CfInsertKey Completed
 
 type SCfInsertKey SCompleted
@@ -3387,6 +3615,20 @@ func (c SCfInsertnxItem) Item(item ...string) SCfInsertnxItem {
 	return c
 }
 
+func (c CfInsertnxItem) ItemBytes(item ...[]byte) CfInsertnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SCfInsertnxItem) ItemBytes(item ...[]byte) SCfInsertnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c CfInsertnxItem) Build() Completed {
 	return Completed(c)
 }
@@ -3409,6 +3651,20 @@ func (c SCfInsertnxItems) Item(item ...string) SCfInsertnxItem {
 	return (SCfInsertnxItem)(c)
 }
 
+func (c CfInsertnxItems) ItemBytes(item ...[]byte) CfInsertnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (CfInsertnxItem)(c)
+}
+
+func (c SCfInsertnxItems) ItemBytes(item ...[]byte) SCfInsertnxItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SCfInsertnxItem)(c)
+}
+
 type CfInsertnxKey Completed
 
 type SCfInsertnxKey SCompleted
@@ -3557,6 +3813,20 @@ func (c SCfMexistsItem) Item(item ...string) SCfMexistsItem {
 	return c
 }
 
+func (c CfMexistsItem) ItemBytes(item ...[]byte) CfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SCfMexistsItem) ItemBytes(item ...[]byte) SCfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c CfMexistsItem) Build() Completed {
 	return Completed(c)
 }
@@ -3579,6 +3849,20 @@ func (c SCfMexistsKey) Item(item ...string) SCfMexistsItem {
 	return (SCfMexistsItem)(c)
 }
 
+func (c CfMexistsKey) ItemBytes(item ...[]byte) CfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (CfMexistsItem)(c)
+}
+
+func (c SCfMexistsKey) ItemBytes(item ...[]byte) SCfMexistsItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SCfMexistsItem)(c)
+}
+
 type CfReserve Completed
 
 type SCfReserve SCompleted
@@ -3759,6 +4043,14 @@ func (c SCfScandumpIterator) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c CfScandumpIterator) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SCfScandumpIterator) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type CfScandumpKey Completed
 
 type SCfScandumpKey SCompleted
@@ -6461,10 +6753,12 @@ type ClusterSlaves Completed
 
 type SClusterSlaves SCompleted
 
+// Deprecated: since 5.0.0
 func (b *Builder) ClusterSlaves() ClusterSlaves {
 	return ClusterSlaves{cs: append(b.get(), "CLUSTER", "SLAVES"), ks: InitSlot}
 }
 
+// Deprecated: since 5.0.0
 func (b *SBuilder) ClusterSlaves() SClusterSlaves {
 	return SClusterSlaves{cs: append(b.get(), "CLUSTER", "SLAVES"), ks: InitSlot}
 }
@@ -6495,10 +6789,12 @@ type ClusterSlots Completed
 
 type SClusterSlots SCompleted
 
+// Deprecated: since 7.0.0
 func (b *Builder) ClusterSlots() ClusterSlots {
 	return ClusterSlots{cs: append(b.get(), "CLUSTER", "SLOTS"), ks: InitSlot}
 }
 
+// Deprecated: since 7.0.0
 func (b *SBuilder) ClusterSlots() SClusterSlots {
 	return SClusterSlots{cs: append(b.get(), "CLUSTER", "SLOTS"), ks: InitSlot}
 }
@@ -6548,6 +6844,16 @@ func (c SCmsIncrbyItemsIncrement) Item(item string) SCmsIncrbyItemsItem {
 	return (SCmsIncrbyItemsItem)(c)
 }
 
+func (c CmsIncrbyItemsIncrement) ItemBytes(item []byte) CmsIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CmsIncrbyItemsItem)(c)
+}
+
+func (c SCmsIncrbyItemsIncrement) ItemBytes(item []byte) SCmsIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCmsIncrbyItemsItem)(c)
+}
+
 func (c CmsIncrbyItemsIncrement) Build() Completed {
 	return Completed(c)
 }
@@ -6584,6 +6890,16 @@ func (c SCmsIncrbyKey) Item(item string) SCmsIncrbyItemsItem {
 	return (SCmsIncrbyItemsItem)(c)
 }
 
+func (c CmsIncrbyKey) ItemBytes(item []byte) CmsIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (CmsIncrbyItemsItem)(c)
+}
+
+func (c SCmsIncrbyKey) ItemBytes(item []byte) SCmsIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (SCmsIncrbyItemsItem)(c)
+}
+
 type CmsInfo Completed
 
 type SCmsInfo SCompleted
@@ -6923,6 +7239,20 @@ func (c SCmsQueryItem) Item(item ...string) SCmsQueryItem {
 	return c
 }
 
+func (c CmsQueryItem) ItemBytes(item ...[]byte) CmsQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SCmsQueryItem) ItemBytes(item ...[]byte) SCmsQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c CmsQueryItem) Build() Completed {
 	return Completed(c)
 }
@@ -6953,6 +7283,20 @@ func (c SCmsQueryKey) Item(item ...string) SCmsQueryItem {
 	return (SCmsQueryItem)(c)
 }
 
+func (c CmsQueryKey) ItemBytes(item ...[]byte) CmsQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (CmsQueryItem)(c)
+}
+
+func (c SCmsQueryKey) ItemBytes(item ...[]byte) SCmsQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SCmsQueryItem)(c)
+}
+
 type Command Completed
 
 type SCommand SCompleted
@@ -7175,6 +7519,16 @@ func (c SConfigSetParameterValue) ParameterValue(parameter string, value string)
 	return c
 }
 
+func (c ConfigSetParameterValue) ParameterValueBytes(parameter string, value []byte) ConfigSetParameterValue {
+	c.cs = append(c.cs, parameter, bstr(value))
+	return c
+}
+
+func (c SConfigSetParameterValue) ParameterValueBytes(parameter string, value []byte) SConfigSetParameterValue {
+	c.cs = append(c.cs, parameter, bstr(value))
+	return c
+}
+
 func (c ConfigSetParameterValue) Build() Completed {
 	return Completed(c)
 }
@@ -7551,6 +7905,14 @@ func (c SDumpKey) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c DumpKey) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SDumpKey) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type Echo Completed
 
 type SEcho SCompleted
@@ -7573,6 +7935,16 @@ func (c SEcho) Message(message string) SEchoMessage {
 	return (SEchoMessage)(c)
 }
 
+func (c Echo) MessageBytes(message []byte) EchoMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (EchoMessage)(c)
+}
+
+func (c SEcho) MessageBytes(message []byte) SEchoMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (SEchoMessage)(c)
+}
+
 type EchoMessage Completed
 
 type SEchoMessage SCompleted
@@ -7621,6 +7993,20 @@ func (c SEvalArg) Arg(arg ...string) SEvalArg {
 	return c
 }
 
+func (c EvalArg) ArgBytes(arg ...[]byte) EvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SEvalArg) ArgBytes(arg ...[]byte) SEvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c EvalArg) Build() Completed {
 	return Completed(c)
 }
@@ -7656,6 +8042,20 @@ func (c SEvalKey) Arg(arg ...string) SEvalArg {
 	return (SEvalArg)(c)
 }
 
+func (c EvalKey) ArgBytes(arg ...[]byte) EvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalArg)(c)
+}
+
+func (c SEvalKey) ArgBytes(arg ...[]byte) SEvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalArg)(c)
+}
+
 func (c EvalKey) Build() Completed {
 	return Completed(c)
 }
@@ -7691,6 +8091,20 @@ func (c SEvalNumkeys) Arg(arg ...string) SEvalArg {
 	return (SEvalArg)(c)
 }
 
+func (c EvalNumkeys) ArgBytes(arg ...[]byte) EvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalArg)(c)
+}
+
+func (c SEvalNumkeys) ArgBytes(arg ...[]byte) SEvalArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalArg)(c)
+}
+
 func (c EvalNumkeys) Build() Completed {
 	return Completed(c)
 }
@@ -7735,6 +8149,20 @@ func (c SEvalRoArg) Arg(arg ...string) SEvalRoArg {
 	return c
 }
 
+func (c EvalRoArg) ArgBytes(arg ...[]byte) EvalRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SEvalRoArg) ArgBytes(arg ...[]byte) SEvalRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c EvalRoArg) Build() Completed {
 	return Completed(c)
 }
@@ -7770,6 +8198,20 @@ func (c SEvalRoKey) Arg(arg ...string) SEvalRoArg {
 	return (SEvalRoArg)(c)
 }
 
+func (c EvalRoKey) ArgBytes(arg ...[]byte) EvalRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalRoArg)(c)
+}
+
+func (c SEvalRoKey) ArgBytes(arg ...[]byte) SEvalRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalRoArg)(c)
+}
+
 type EvalRoNumkeys Completed
 
 type SEvalRoNumkeys SCompleted
@@ -7851,6 +8293,20 @@ func (c SEvalshaArg) Arg(arg ...string) SEvalshaArg {
 	return c
 }
 
+func (c EvalshaArg) ArgBytes(arg ...[]byte) EvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SEvalshaArg) ArgBytes(arg ...[]byte) SEvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c EvalshaArg) Build() Completed {
 	return Completed(c)
 }
@@ -7886,6 +8342,20 @@ func (c SEvalshaKey) Arg(arg ...string) SEvalshaArg {
 	return (SEvalshaArg)(c)
 }
 
+func (c EvalshaKey) ArgBytes(arg ...[]byte) EvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalshaArg)(c)
+}
+
+func (c SEvalshaKey) ArgBytes(arg ...[]byte) SEvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalshaArg)(c)
+}
+
 func (c EvalshaKey) Build() Completed {
 	return Completed(c)
 }
@@ -7921,6 +8391,20 @@ func (c SEvalshaNumkeys) Arg(arg ...string) SEvalshaArg {
 	return (SEvalshaArg)(c)
 }
 
+func (c EvalshaNumkeys) ArgBytes(arg ...[]byte) EvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalshaArg)(c)
+}
+
+func (c SEvalshaNumkeys) ArgBytes(arg ...[]byte) SEvalshaArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalshaArg)(c)
+}
+
 func (c EvalshaNumkeys) Build() Completed {
 	return Completed(c)
 }
@@ -7965,6 +8449,20 @@ func (c SEvalshaRoArg) Arg(arg ...string) SEvalshaRoArg {
 	return c
 }
 
+func (c EvalshaRoArg) ArgBytes(arg ...[]byte) EvalshaRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SEvalshaRoArg) ArgBytes(arg ...[]byte) SEvalshaRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c EvalshaRoArg) Build() Completed {
 	return Completed(c)
 }
@@ -8000,6 +8498,20 @@ func (c SEvalshaRoKey) Arg(arg ...string) SEvalshaRoArg {
 	return (SEvalshaRoArg)(c)
 }
 
+func (c EvalshaRoKey) ArgBytes(arg ...[]byte) EvalshaRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (EvalshaRoArg)(c)
+}
+
+func (c SEvalshaRoKey) ArgBytes(arg ...[]byte) SEvalshaRoArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SEvalshaRoArg)(c)
+}
+
 type EvalshaRoNumkeys Completed
 
 type SEvalshaRoNumkeys SCompleted
@@ -9042,6 +9554,20 @@ func (c SFtAggregateGroupbyReduceArg) Arg(arg ...string) SFtAggregateGroupbyRedu
 	return c
 }
 
+func (c FtAggregateGroupbyReduceArg) ArgBytes(arg ...[]byte) FtAggregateGroupbyReduceArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SFtAggregateGroupbyReduceArg) ArgBytes(arg ...[]byte) SFtAggregateGroupbyReduceArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c FtAggregateGroupbyReduceArg) As(name string) FtAggregateGroupbyReduceAs {
 	c.cs = append(c.cs, "AS", name)
 	return (FtAggregateGroupbyReduceAs)(c)
@@ -9226,6 +9752,20 @@ func (c SFtAggregateGroupbyReduceNargs) Arg(arg ...string) SFtAggregateGroupbyRe
 	return (SFtAggregateGroupbyReduceArg)(c)
 }
 
+func (c FtAggregateGroupbyReduceNargs) ArgBytes(arg ...[]byte) FtAggregateGroupbyReduceArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (FtAggregateGroupbyReduceArg)(c)
+}
+
+func (c SFtAggregateGroupbyReduceNargs) ArgBytes(arg ...[]byte) SFtAggregateGroupbyReduceArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SFtAggregateGroupbyReduceArg)(c)
+}
+
 type FtAggregateGroupbyReduceReduce Completed
 
 type SFtAggregateGroupbyReduceReduce SCompleted
@@ -10220,6 +10760,16 @@ func (c SFtConfigGetOption) Value(value string) SFtConfigGetValue {
 	return (SFtConfigGetValue)(c)
 }
 
+func (c FtConfigGetOption) ValueBytes(value []byte) FtConfigGetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (FtConfigGetValue)(c)
+}
+
+func (c SFtConfigGetOption) ValueBytes(value []byte) SFtConfigGetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SFtConfigGetValue)(c)
+}
+
 type FtConfigGetValue Completed
 
 type SFtConfigGetValue SCompleted
@@ -17979,6 +18529,16 @@ func (c SGeoaddLongitudeLatitudeMember) LongitudeLatitudeMember(longitude float6
 	return c
 }
 
+func (c GeoaddLongitudeLatitudeMember) LongitudeLatitudeMemberBytes(longitude float64, latitude float64, member []byte) GeoaddLongitudeLatitudeMember {
+	c.cs = append(c.cs, strconv.FormatFloat(longitude, 'f', -1, 64), strconv.FormatFloat(latitude, 'f', -1, 64), bstr(member))
+	return c
+}
+
+func (c SGeoaddLongitudeLatitudeMember) LongitudeLatitudeMemberBytes(longitude float64, latitude float64, member []byte) SGeoaddLongitudeLatitudeMember {
+	c.cs = append(c.cs, strconv.FormatFloat(longitude, 'f', -1, 64), strconv.FormatFloat(latitude, 'f', -1, 64), bstr(member))
+	return c
+}
+
 func (c GeoaddLongitudeLatitudeMember) Build() Completed {
 	return Completed(c)
 }
@@ -18215,6 +18775,20 @@ func (c SGeohashKey) Member(member ...string) SGeohashMember {
 	return (SGeohashMember)(c)
 }
 
+func (c GeohashKey) MemberBytes(member ...[]byte) GeohashMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (GeohashMember)(c)
+}
+
+func (c SGeohashKey) MemberBytes(member ...[]byte) SGeohashMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SGeohashMember)(c)
+}
+
 type GeohashMember Completed
 
 type SGeohashMember SCompleted
@@ -18229,6 +18803,20 @@ func (c SGeohashMember) Member(member ...string) SGeohashMember {
 	return c
 }
 
+func (c GeohashMember) MemberBytes(member ...[]byte) GeohashMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SGeohashMember) MemberBytes(member ...[]byte) SGeohashMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c GeohashMember) Build() Completed {
 	return Completed(c)
 }
@@ -18282,6 +18870,20 @@ func (c SGeoposKey) Member(member ...string) SGeoposMember {
 	return (SGeoposMember)(c)
 }
 
+func (c GeoposKey) MemberBytes(member ...[]byte) GeoposMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (GeoposMember)(c)
+}
+
+func (c SGeoposKey) MemberBytes(member ...[]byte) SGeoposMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SGeoposMember)(c)
+}
+
 type GeoposMember Completed
 
 type SGeoposMember SCompleted
@@ -18296,6 +18898,20 @@ func (c SGeoposMember) Member(member ...string) SGeoposMember {
 	return c
 }
 
+func (c GeoposMember) MemberBytes(member ...[]byte) GeoposMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SGeoposMember) MemberBytes(member ...[]byte) SGeoposMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c GeoposMember) Build() Completed {
 	return Completed(c)
 }
@@ -18316,10 +18932,12 @@ type Georadius Completed
 
 type SGeoradius SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Georadius() Georadius {
 	return Georadius{cs: append(b.get(), "GEORADIUS"), ks: InitSlot}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Georadius() SGeoradius {
 	return SGeoradius{cs: append(b.get(), "GEORADIUS"), ks: InitSlot}
 }
@@ -18611,10 +19229,12 @@ type GeoradiusRo Completed
 
 type SGeoradiusRo SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) GeoradiusRo() GeoradiusRo {
 	return GeoradiusRo{cs: append(b.get(), "GEORADIUS_RO"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) GeoradiusRo() SGeoradiusRo {
 	return SGeoradiusRo{cs: append(b.get(), "GEORADIUS_RO"), ks: InitSlot, cf: readonly}
 }
@@ -20124,10 +20744,12 @@ type Georadiusbymember Completed
 
 type SGeoradiusbymember SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Georadiusbymember() Georadiusbymember {
 	return Georadiusbymember{cs: append(b.get(), "GEORADIUSBYMEMBER"), ks: InitSlot}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Georadiusbymember() SGeoradiusbymember {
 	return SGeoradiusbymember{cs: append(b.get(), "GEORADIUSBYMEMBER"), ks: InitSlot}
 }
@@ -20275,6 +20897,16 @@ func (c SGeoradiusbymemberKey) Member(member string) SGeoradiusbymemberMember {
 	return (SGeoradiusbymemberMember)(c)
 }
 
+func (c GeoradiusbymemberKey) MemberBytes(member []byte) GeoradiusbymemberMember {
+	c.cs = append(c.cs, bstr(member))
+	return (GeoradiusbymemberMember)(c)
+}
+
+func (c SGeoradiusbymemberKey) MemberBytes(member []byte) SGeoradiusbymemberMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SGeoradiusbymemberMember)(c)
+}
+
 type GeoradiusbymemberMember Completed
 
 type SGeoradiusbymemberMember SCompleted
@@ -20405,10 +21037,12 @@ type GeoradiusbymemberRo Completed
 
 type SGeoradiusbymemberRo SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) GeoradiusbymemberRo() GeoradiusbymemberRo {
 	return GeoradiusbymemberRo{cs: append(b.get(), "GEORADIUSBYMEMBER_RO"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) GeoradiusbymemberRo() SGeoradiusbymemberRo {
 	return SGeoradiusbymemberRo{cs: append(b.get(), "GEORADIUSBYMEMBER_RO"), ks: InitSlot, cf: readonly}
 }
@@ -20550,6 +21184,16 @@ func (c SGeoradiusbymemberRoKey) Member(member string) SGeoradiusbymemberRoMembe
 	return (SGeoradiusbymemberRoMember)(c)
 }
 
+func (c GeoradiusbymemberRoKey) MemberBytes(member []byte) GeoradiusbymemberRoMember {
+	c.cs = append(c.cs, bstr(member))
+	return (GeoradiusbymemberRoMember)(c)
+}
+
+func (c SGeoradiusbymemberRoKey) MemberBytes(member []byte) SGeoradiusbymemberRoMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SGeoradiusbymemberRoMember)(c)
+}
+
 type GeoradiusbymemberRoMember Completed
 
 type SGeoradiusbymemberRoMember SCompleted
@@ -23039,6 +23683,16 @@ func (c SGeosearchKey) Frommember(member string) SGeosearchFrommember {
 	return (SGeosearchFrommember)(c)
 }
 
+func (c GeosearchKey) FrommemberBytes(member []byte) GeosearchFrommember {
+	c.cs = append(c.cs, "FROMMEMBER", bstr(member))
+	return (GeosearchFrommember)(c)
+}
+
+func (c SGeosearchKey) FrommemberBytes(member []byte) SGeosearchFrommember {
+	c.cs = append(c.cs, "FROMMEMBER", bstr(member))
+	return (SGeosearchFrommember)(c)
+}
+
 func (c GeosearchKey) Fromlonlat(longitude float64, latitude float64) GeosearchFromlonlat {
 	c.cs = append(c.cs, "FROMLONLAT", strconv.FormatFloat(longitude, 'f', -1, 64), strconv.FormatFloat(latitude, 'f', -1, 64))
 	return (GeosearchFromlonlat)(c)
@@ -24237,6 +24891,16 @@ func (c SGeosearchstoreSource) Frommember(member string) SGeosearchstoreFrommemb
 	return (SGeosearchstoreFrommember)(c)
 }
 
+func (c GeosearchstoreSource) FrommemberBytes(member []byte) GeosearchstoreFrommember {
+	c.cs = append(c.cs, "FROMMEMBER", bstr(member))
+	return (GeosearchstoreFrommember)(c)
+}
+
+func (c SGeosearchstoreSource) FrommemberBytes(member []byte) SGeosearchstoreFrommember {
+	c.cs = append(c.cs, "FROMMEMBER", bstr(member))
+	return (SGeosearchstoreFrommember)(c)
+}
+
 func (c GeosearchstoreSource) Fromlonlat(longitude float64, latitude float64) GeosearchstoreFromlonlat {
 	c.cs = append(c.cs, "FROMLONLAT", strconv.FormatFloat(longitude, 'f', -1, 64), strconv.FormatFloat(latitude, 'f', -1, 64))
 	return (GeosearchstoreFromlonlat)(c)
@@ -24682,10 +25346,12 @@ type Getset Completed
 
 type SGetset SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Getset() Getset {
 	return Getset{cs: append(b.get(), "GETSET"), ks: InitSlot}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Getset() SGetset {
 	return SGetset{cs: append(b.get(), "GETSET"), ks: InitSlot}
 }
@@ -24715,6 +25381,16 @@ func (c SGetsetKey) Value(value string) SGetsetValue {
 	return (SGetsetValue)(c)
 }
 
+func (c GetsetKey) ValueBytes(value []byte) GetsetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (GetsetValue)(c)
+}
+
+func (c SGetsetKey) ValueBytes(value []byte) SGetsetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SGetsetValue)(c)
+}
+
 type GetsetValue Completed
 
 type SGetsetValue SCompleted
@@ -24797,6 +25473,16 @@ func (c SGraphConfigSetName) Value(value string) SGraphConfigSetValue {
 	return (SGraphConfigSetValue)(c)
 }
 
+func (c GraphConfigSetName) ValueBytes(value []byte) GraphConfigSetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (GraphConfigSetValue)(c)
+}
+
+func (c SGraphConfigSetName) ValueBytes(value []byte) SGraphConfigSetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SGraphConfigSetValue)(c)
+}
+
 type GraphConfigSetValue Completed
 
 type SGraphConfigSetValue SCompleted
@@ -24893,6 +25579,14 @@ func (c SGraphExplainQuery) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c GraphExplainQuery) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SGraphExplainQuery) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type GraphList Completed
 
 type SGraphList SCompleted
@@ -25690,10 +26384,12 @@ type Hmset Completed
 
 type SHmset SCompleted
 
+// Deprecated: since 4.0.0
 func (b *Builder) Hmset() Hmset {
 	return Hmset{cs: append(b.get(), "HMSET"), ks: InitSlot}
 }
 
+// Deprecated: since 4.0.0
 func (b *SBuilder) Hmset() SHmset {
 	return SHmset{cs: append(b.get(), "HMSET"), ks: InitSlot}
 }
@@ -25723,6 +26419,16 @@ func (c SHmsetFieldValue) FieldValue(field string, value string) SHmsetFieldValu
 	return c
 }
 
+func (c HmsetFieldValue) FieldValueBytes(field string, value []byte) HmsetFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
+func (c SHmsetFieldValue) FieldValueBytes(field string, value []byte) SHmsetFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
 func (c HmsetFieldValue) Build() Completed {
 	return Completed(c)
 }
@@ -25962,6 +26668,16 @@ func (c SHsetFieldValue) FieldValue(field string, value string) SHsetFieldValue
 	return c
 }
 
+func (c HsetFieldValue) FieldValueBytes(field string, value []byte) HsetFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
+func (c SHsetFieldValue) FieldValueBytes(field string, value []byte) SHsetFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
 func (c HsetFieldValue) Build() Completed {
 	return Completed(c)
 }
@@ -26019,6 +26735,16 @@ func (c SHsetnxField) Value(value string) SHsetnxValue {
 	return (SHsetnxValue)(c)
 }
 
+func (c HsetnxField) ValueBytes(value []byte) HsetnxValue {
+	c.cs = append(c.cs, bstr(value))
+	return (HsetnxValue)(c)
+}
+
+func (c SHsetnxField) ValueBytes(value []byte) SHsetnxValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SHsetnxValue)(c)
+}
+
 type HsetnxKey Completed
 
 type SHsetnxKey SCompleted
@@ -26367,6 +27093,20 @@ func (c SJsonArrappendKey) Value(value ...string) SJsonArrappendValue {
 	return (SJsonArrappendValue)(c)
 }
 
+func (c JsonArrappendKey) ValueBytes(value ...[]byte) JsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (JsonArrappendValue)(c)
+}
+
+func (c SJsonArrappendKey) ValueBytes(value ...[]byte) SJsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SJsonArrappendValue)(c)
+}
+
 type JsonArrappendPath Completed
 
 type SJsonArrappendPath SCompleted
@@ -26381,6 +27121,20 @@ func (c SJsonArrappendPath) Value(value ...string) SJsonArrappendValue {
 	return (SJsonArrappendValue)(c)
 }
 
+func (c JsonArrappendPath) ValueBytes(value ...[]byte) JsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (JsonArrappendValue)(c)
+}
+
+func (c SJsonArrappendPath) ValueBytes(value ...[]byte) SJsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SJsonArrappendValue)(c)
+}
+
 type JsonArrappendValue Completed
 
 type SJsonArrappendValue SCompleted
@@ -26395,6 +27149,20 @@ func (c SJsonArrappendValue) Value(value ...string) SJsonArrappendValue {
 	return c
 }
 
+func (c JsonArrappendValue) ValueBytes(value ...[]byte) JsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SJsonArrappendValue) ValueBytes(value ...[]byte) SJsonArrappendValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c JsonArrappendValue) Build() Completed {
 	return Completed(c)
 }
@@ -26454,6 +27222,16 @@ func (c SJsonArrindexPath) Value(value string) SJsonArrindexValue {
 	return (SJsonArrindexValue)(c)
 }
 
+func (c JsonArrindexPath) ValueBytes(value []byte) JsonArrindexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (JsonArrindexValue)(c)
+}
+
+func (c SJsonArrindexPath) ValueBytes(value []byte) SJsonArrindexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SJsonArrindexValue)(c)
+}
+
 type JsonArrindexStartStart Completed
 
 type SJsonArrindexStartStart SCompleted
@@ -26571,6 +27349,20 @@ func (c SJsonArrinsertIndex) Value(value ...string) SJsonArrinsertValue {
 	return (SJsonArrinsertValue)(c)
 }
 
+func (c JsonArrinsertIndex) ValueBytes(value ...[]byte) JsonArrinsertValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (JsonArrinsertValue)(c)
+}
+
+func (c SJsonArrinsertIndex) ValueBytes(value ...[]byte) SJsonArrinsertValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SJsonArrinsertValue)(c)
+}
+
 type JsonArrinsertKey Completed
 
 type SJsonArrinsertKey SCompleted
@@ -26613,6 +27405,20 @@ func (c SJsonArrinsertValue) Value(value ...string) SJsonArrinsertValue {
 	return c
 }
 
+func (c JsonArrinsertValue) ValueBytes(value ...[]byte) JsonArrinsertValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SJsonArrinsertValue) ValueBytes(value ...[]byte) SJsonArrinsertValue {
+	for _, n := range value {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c JsonArrinsertValue) Build() Completed {
 	return Completed(c)
 }
@@ -27612,6 +28418,16 @@ func (c SJsonSetPath) Value(value string) SJsonSetValue {
 	return (SJsonSetValue)(c)
 }
 
+func (c JsonSetPath) ValueBytes(value []byte) JsonSetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (JsonSetValue)(c)
+}
+
+func (c SJsonSetPath) ValueBytes(value []byte) SJsonSetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SJsonSetValue)(c)
+}
+
 type JsonSetValue Completed
 
 type SJsonSetValue SCompleted
@@ -27691,6 +28507,16 @@ func (c SJsonStrappendKey) Value(value string) SJsonStrappendValue {
 	return (SJsonStrappendValue)(c)
 }
 
+func (c JsonStrappendKey) ValueBytes(value []byte) JsonStrappendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (JsonStrappendValue)(c)
+}
+
+func (c SJsonStrappendKey) ValueBytes(value []byte) SJsonStrappendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SJsonStrappendValue)(c)
+}
+
 type JsonStrappendPath Completed
 
 type SJsonStrappendPath SCompleted
@@ -27705,6 +28531,16 @@ func (c SJsonStrappendPath) Value(value string) SJsonStrappendValue {
 	return (SJsonStrappendValue)(c)
 }
 
+func (c JsonStrappendPath) ValueBytes(value []byte) JsonStrappendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (JsonStrappendValue)(c)
+}
+
+func (c SJsonStrappendPath) ValueBytes(value []byte) SJsonStrappendValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SJsonStrappendValue)(c)
+}
+
 type JsonStrappendValue Completed
 
 type SJsonStrappendValue SCompleted
@@ -28482,6 +29318,16 @@ func (c SLinsertPivot) Element(element string) SLinsertElement {
 	return (SLinsertElement)(c)
 }
 
+func (c LinsertPivot) ElementBytes(element []byte) LinsertElement {
+	c.cs = append(c.cs, bstr(element))
+	return (LinsertElement)(c)
+}
+
+func (c SLinsertPivot) ElementBytes(element []byte) SLinsertElement {
+	c.cs = append(c.cs, bstr(element))
+	return (SLinsertElement)(c)
+}
+
 type LinsertWhereAfter Completed
 
 type SLinsertWhereAfter SCompleted
@@ -29035,6 +29881,16 @@ func (c SLposKey) Element(element string) SLposElement {
 	return (SLposElement)(c)
 }
 
+func (c LposKey) ElementBytes(element []byte) LposElement {
+	c.cs = append(c.cs, bstr(element))
+	return (LposElement)(c)
+}
+
+func (c SLposKey) ElementBytes(element []byte) SLposElement {
+	c.cs = append(c.cs, bstr(element))
+	return (SLposElement)(c)
+}
+
 type LposMaxlen Completed
 
 type SLposMaxlen SCompleted
@@ -29132,6 +29988,20 @@ func (c SLpushElement) Element(element ...string) SLpushElement {
 	return c
 }
 
+func (c LpushElement) ElementBytes(element ...[]byte) LpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SLpushElement) ElementBytes(element ...[]byte) SLpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c LpushElement) Build() Completed {
 	return Completed(c)
 }
@@ -29154,6 +30024,20 @@ func (c SLpushKey) Element(element ...string) SLpushElement {
 	return (SLpushElement)(c)
 }
 
+func (c LpushKey) ElementBytes(element ...[]byte) LpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (LpushElement)(c)
+}
+
+func (c SLpushKey) ElementBytes(element ...[]byte) SLpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SLpushElement)(c)
+}
+
 type Lpushx Completed
 
 type SLpushx SCompleted
@@ -29191,6 +30075,20 @@ func (c SLpushxElement) Element(element ...string) SLpushxElement {
 	return c
 }
 
+func (c LpushxElement) ElementBytes(element ...[]byte) LpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SLpushxElement) ElementBytes(element ...[]byte) SLpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c LpushxElement) Build() Completed {
 	return Completed(c)
 }
@@ -29213,6 +30111,20 @@ func (c SLpushxKey) Element(element ...string) SLpushxElement {
 	return (SLpushxElement)(c)
 }
 
+func (c LpushxKey) ElementBytes(element ...[]byte) LpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (LpushxElement)(c)
+}
+
+func (c SLpushxKey) ElementBytes(element ...[]byte) SLpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SLpushxElement)(c)
+}
+
 type Lrange Completed
 
 type SLrange SCompleted
@@ -29321,6 +30233,16 @@ func (c SLremCount) Element(element string) SLremElement {
 	return (SLremElement)(c)
 }
 
+func (c LremCount) ElementBytes(element []byte) LremElement {
+	c.cs = append(c.cs, bstr(element))
+	return (LremElement)(c)
+}
+
+func (c SLremCount) ElementBytes(element []byte) SLremElement {
+	c.cs = append(c.cs, bstr(element))
+	return (SLremElement)(c)
+}
+
 type LremElement Completed
 
 type SLremElement SCompleted
@@ -29396,6 +30318,16 @@ func (c SLsetIndex) Element(element string) SLsetElement {
 	return (SLsetElement)(c)
 }
 
+func (c LsetIndex) ElementBytes(element []byte) LsetElement {
+	c.cs = append(c.cs, bstr(element))
+	return (LsetElement)(c)
+}
+
+func (c SLsetIndex) ElementBytes(element []byte) SLsetElement {
+	c.cs = append(c.cs, bstr(element))
+	return (SLsetElement)(c)
+}
+
 type LsetKey Completed
 
 type SLsetKey SCompleted
@@ -30100,6 +31032,20 @@ func (c SModuleLoadArg) Arg(arg ...string) SModuleLoadArg {
 	return c
 }
 
+func (c ModuleLoadArg) ArgBytes(arg ...[]byte) ModuleLoadArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SModuleLoadArg) ArgBytes(arg ...[]byte) SModuleLoadArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c ModuleLoadArg) Build() Completed {
 	return Completed(c)
 }
@@ -30122,6 +31068,20 @@ func (c SModuleLoadPath) Arg(arg ...string) SModuleLoadArg {
 	return (SModuleLoadArg)(c)
 }
 
+func (c ModuleLoadPath) ArgBytes(arg ...[]byte) ModuleLoadArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (ModuleLoadArg)(c)
+}
+
+func (c SModuleLoadPath) ArgBytes(arg ...[]byte) SModuleLoadArg {
+	for _, n := range arg {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SModuleLoadArg)(c)
+}
+
 func (c ModuleLoadPath) Build() Completed {
 	return Completed(c)
 }
@@ -30268,6 +31228,17 @@ func (c SMsetKeyValue) KeyValue(key string, value string) SMsetKeyValue {
 	return c
 }
 
+func (c MsetKeyValue) KeyValueBytes(key string, value []byte) MsetKeyValue {
+	c.cs = append(c.cs, key, bstr(value))
+	return c
+}
+
+func (c SMsetKeyValue) KeyValueBytes(key string, value []byte) SMsetKeyValue {
+	c.ks = checkSlot(c.ks, slot(key))
+	c.cs = append(c.cs, key, bstr(value))
+	return c
+}
+
 func (c MsetKeyValue) Build() Completed {
 	return Completed(c)
 }
@@ -30311,6 +31282,17 @@ func (c SMsetnxKeyValue) KeyValue(key string, value string) SMsetnxKeyValue {
 	return c
 }
 
+func (c MsetnxKeyValue) KeyValueBytes(key string, value []byte) MsetnxKeyValue {
+	c.cs = append(c.cs, key, bstr(value))
+	return c
+}
+
+func (c SMsetnxKeyValue) KeyValueBytes(key string, value []byte) SMsetnxKeyValue {
+	c.ks = checkSlot(c.ks, slot(key))
+	c.cs = append(c.cs, key, bstr(value))
+	return c
+}
+
 func (c MsetnxKeyValue) Build() Completed {
 	return Completed(c)
 }
@@ -30888,6 +31870,20 @@ func (c SPfaddElement) Element(element ...string) SPfaddElement {
 	return c
 }
 
+func (c PfaddElement) ElementBytes(element ...[]byte) PfaddElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SPfaddElement) ElementBytes(element ...[]byte) SPfaddElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c PfaddElement) Build() Completed {
 	return Completed(c)
 }
@@ -30910,6 +31906,20 @@ func (c SPfaddKey) Element(element ...string) SPfaddElement {
 	return (SPfaddElement)(c)
 }
 
+func (c PfaddKey) ElementBytes(element ...[]byte) PfaddElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (PfaddElement)(c)
+}
+
+func (c SPfaddKey) ElementBytes(element ...[]byte) SPfaddElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SPfaddElement)(c)
+}
+
 func (c PfaddKey) Build() Completed {
 	return Completed(c)
 }
@@ -31055,6 +32065,16 @@ func (c SPing) Message(message string) SPingMessage {
 	return (SPingMessage)(c)
 }
 
+func (c Ping) MessageBytes(message []byte) PingMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (PingMessage)(c)
+}
+
+func (c SPing) MessageBytes(message []byte) SPingMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (SPingMessage)(c)
+}
+
 func (c Ping) Build() Completed {
 	return Completed(c)
 }
@@ -31079,10 +32099,12 @@ type Psetex Completed
 
 type SPsetex SCompleted
 
+// Deprecated: since 2.6.12
 func (b *Builder) Psetex() Psetex {
 	return Psetex{cs: append(b.get(), "PSETEX"), ks: InitSlot}
 }
 
+// Deprecated: since 2.6.12
 func (b *SBuilder) Psetex() SPsetex {
 	return SPsetex{cs: append(b.get(), "PSETEX"), ks: InitSlot}
 }
@@ -31126,6 +32148,16 @@ func (c SPsetexMilliseconds) Value(value string) SPsetexValue {
 	return (SPsetexValue)(c)
 }
 
+func (c PsetexMilliseconds) ValueBytes(value []byte) PsetexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (PsetexValue)(c)
+}
+
+func (c SPsetexMilliseconds) ValueBytes(value []byte) SPsetexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SPsetexValue)(c)
+}
+
 type PsetexValue Completed
 
 type SPsetexValue SCompleted
@@ -31309,6 +32341,16 @@ func (c SPublishChannel) Message(message string) SPublishMessage {
 	return (SPublishMessage)(c)
 }
 
+func (c PublishChannel) MessageBytes(message []byte) PublishMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (PublishMessage)(c)
+}
+
+func (c SPublishChannel) MessageBytes(message []byte) SPublishMessage {
+	c.cs = append(c.cs, bstr(message))
+	return (SPublishMessage)(c)
+}
+
 type PublishMessage Completed
 
 type SPublishMessage SCompleted
@@ -31966,6 +33008,16 @@ func (c SRestoreTtl) SerializedValue(serializedValue string) SRestoreSerializedV
 	return (SRestoreSerializedValue)(c)
 }
 
+func (c RestoreTtl) SerializedValueBytes(serializedValue []byte) RestoreSerializedValue {
+	c.cs = append(c.cs, bstr(serializedValue))
+	return (RestoreSerializedValue)(c)
+}
+
+func (c SRestoreTtl) SerializedValueBytes(serializedValue []byte) SRestoreSerializedValue {
+	c.cs = append(c.cs, bstr(serializedValue))
+	return (SRestoreSerializedValue)(c)
+}
+
 type Role Completed
 
 type SRole SCompleted
@@ -32047,10 +33099,12 @@ type Rpoplpush Completed
 
 type SRpoplpush SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Rpoplpush() Rpoplpush {
 	return Rpoplpush{cs: append(b.get(), "RPOPLPUSH"), ks: InitSlot}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Rpoplpush() SRpoplpush {
 	return SRpoplpush{cs: append(b.get(), "RPOPLPUSH"), ks: InitSlot}
 }
@@ -32130,6 +33184,20 @@ func (c SRpushElement) Element(element ...string) SRpushElement {
 	return c
 }
 
+func (c RpushElement) ElementBytes(element ...[]byte) RpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SRpushElement) ElementBytes(element ...[]byte) SRpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c RpushElement) Build() Completed {
 	return Completed(c)
 }
@@ -32152,6 +33220,20 @@ func (c SRpushKey) Element(element ...string) SRpushElement {
 	return (SRpushElement)(c)
 }
 
+func (c RpushKey) ElementBytes(element ...[]byte) RpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (RpushElement)(c)
+}
+
+func (c SRpushKey) ElementBytes(element ...[]byte) SRpushElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SRpushElement)(c)
+}
+
 type Rpushx Completed
 
 type SRpushx SCompleted
@@ -32189,6 +33271,20 @@ func (c SRpushxElement) Element(element ...string) SRpushxElement {
 	return c
 }
 
+func (c RpushxElement) ElementBytes(element ...[]byte) RpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SRpushxElement) ElementBytes(element ...[]byte) SRpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c RpushxElement) Build() Completed {
 	return Completed(c)
 }
@@ -32211,6 +33307,20 @@ func (c SRpushxKey) Element(element ...string) SRpushxElement {
 	return (SRpushxElement)(c)
 }
 
+func (c RpushxKey) ElementBytes(element ...[]byte) RpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (RpushxElement)(c)
+}
+
+func (c SRpushxKey) ElementBytes(element ...[]byte) SRpushxElement {
+	for _, n := range element {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SRpushxElement)(c)
+}
+
 type Sadd Completed
 
 type SSadd SCompleted
@@ -32248,6 +33358,20 @@ func (c SSaddKey) Member(member ...string) SSaddMember {
 	return (SSaddMember)(c)
 }
 
+func (c SaddKey) MemberBytes(member ...[]byte) SaddMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SaddMember)(c)
+}
+
+func (c SSaddKey) MemberBytes(member ...[]byte) SSaddMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SSaddMember)(c)
+}
+
 type SaddMember Completed
 
 type SSaddMember SCompleted
@@ -32262,6 +33386,20 @@ func (c SSaddMember) Member(member ...string) SSaddMember {
 	return c
 }
 
+func (c SaddMember) MemberBytes(member ...[]byte) SaddMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SSaddMember) MemberBytes(member ...[]byte) SSaddMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c SaddMember) Build() Completed {
 	return Completed(c)
 }
@@ -33155,6 +34293,16 @@ func (c SSetKey) Value(value string) SSetValue {
 	return (SSetValue)(c)
 }
 
+func (c SetKey) ValueBytes(value []byte) SetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SetValue)(c)
+}
+
+func (c SSetKey) ValueBytes(value []byte) SSetValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SSetValue)(c)
+}
+
 type SetValue Completed
 
 type SSetValue SCompleted
@@ -33314,10 +34462,12 @@ type Setex Completed
 
 type SSetex SCompleted
 
+// Deprecated: since 2.6.12
 func (b *Builder) Setex() Setex {
 	return Setex{cs: append(b.get(), "SETEX"), ks: InitSlot}
 }
 
+// Deprecated: since 2.6.12
 func (b *SBuilder) Setex() SSetex {
 	return SSetex{cs: append(b.get(), "SETEX"), ks: InitSlot}
 }
@@ -33361,6 +34511,16 @@ func (c SSetexSeconds) Value(value string) SSetexValue {
 	return (SSetexValue)(c)
 }
 
+func (c SetexSeconds) ValueBytes(value []byte) SetexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SetexValue)(c)
+}
+
+func (c SSetexSeconds) ValueBytes(value []byte) SSetexValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SSetexValue)(c)
+}
+
 type SetexValue Completed
 
 type SSetexValue SCompleted
@@ -33377,10 +34537,12 @@ type Setnx Completed
 
 type SSetnx SCompleted
 
+// Deprecated: since 2.6.12
 func (b *Builder) Setnx() Setnx {
 	return Setnx{cs: append(b.get(), "SETNX"), ks: InitSlot}
 }
 
+// Deprecated: since 2.6.12
 func (b *SBuilder) Setnx() SSetnx {
 	return SSetnx{cs: append(b.get(), "SETNX"), ks: InitSlot}
 }
@@ -33410,6 +34572,16 @@ func (c SSetnxKey) Value(value string) SSetnxValue {
 	return (SSetnxValue)(c)
 }
 
+func (c SetnxKey) ValueBytes(value []byte) SetnxValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SetnxValue)(c)
+}
+
+func (c SSetnxKey) ValueBytes(value []byte) SSetnxValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SSetnxValue)(c)
+}
+
 type SetnxValue Completed
 
 type SSetnxValue SCompleted
@@ -33473,6 +34645,16 @@ func (c SSetrangeOffset) Value(value string) SSetrangeValue {
 	return (SSetrangeValue)(c)
 }
 
+func (c SetrangeOffset) ValueBytes(value []byte) SetrangeValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SetrangeValue)(c)
+}
+
+func (c SSetrangeOffset) ValueBytes(value []byte) SSetrangeValue {
+	c.cs = append(c.cs, bstr(value))
+	return (SSetrangeValue)(c)
+}
+
 type SetrangeValue Completed
 
 type SSetrangeValue SCompleted
@@ -33787,6 +34969,16 @@ func (c SSismemberKey) Member(member string) SSismemberMember {
 	return (SSismemberMember)(c)
 }
 
+func (c SismemberKey) MemberBytes(member []byte) SismemberMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SismemberMember)(c)
+}
+
+func (c SSismemberKey) MemberBytes(member []byte) SSismemberMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SSismemberMember)(c)
+}
+
 type SismemberMember Completed
 
 type SSismemberMember SCompleted
@@ -33811,10 +35003,12 @@ type Slaveof Completed
 
 type SSlaveof SCompleted
 
+// Deprecated: since 5.0.0
 func (b *Builder) Slaveof() Slaveof {
 	return Slaveof{cs: append(b.get(), "SLAVEOF"), ks: InitSlot}
 }
 
+// Deprecated: since 5.0.0
 func (b *SBuilder) Slaveof() SSlaveof {
 	return SSlaveof{cs: append(b.get(), "SLAVEOF"), ks: InitSlot}
 }
@@ -34037,6 +35231,20 @@ func (c SSmismemberKey) Member(member ...string) SSmismemberMember {
 	return (SSmismemberMember)(c)
 }
 
+func (c SmismemberKey) MemberBytes(member ...[]byte) SmismemberMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SmismemberMember)(c)
+}
+
+func (c SSmismemberKey) MemberBytes(member ...[]byte) SSmismemberMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SSmismemberMember)(c)
+}
+
 type SmismemberMember Completed
 
 type SSmismemberMember SCompleted
@@ -34051,6 +35259,20 @@ func (c SSmismemberMember) Member(member ...string) SSmismemberMember {
 	return c
 }
 
+func (c SmismemberMember) MemberBytes(member ...[]byte) SmismemberMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SSmismemberMember) MemberBytes(member ...[]byte) SSmismemberMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c SmismemberMember) Build() Completed {
 	return Completed(c)
 }
@@ -34104,6 +35326,16 @@ func (c SSmoveDestination) Member(member string) SSmoveMember {
 	return (SSmoveMember)(c)
 }
 
+func (c SmoveDestination) MemberBytes(member []byte) SmoveMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SmoveMember)(c)
+}
+
+func (c SSmoveDestination) MemberBytes(member []byte) SSmoveMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SSmoveMember)(c)
+}
+
 type SmoveMember Completed
 
 type SSmoveMember SCompleted
@@ -35077,6 +36309,20 @@ func (c SSremKey) Member(member ...string) SSremMember {
 	return (SSremMember)(c)
 }
 
+func (c SremKey) MemberBytes(member ...[]byte) SremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SremMember)(c)
+}
+
+func (c SSremKey) MemberBytes(member ...[]byte) SSremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SSremMember)(c)
+}
+
 type SremMember Completed
 
 type SSremMember SCompleted
@@ -35091,6 +36337,20 @@ func (c SSremMember) Member(member ...string) SSremMember {
 	return c
 }
 
+func (c SremMember) MemberBytes(member ...[]byte) SremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SSremMember) MemberBytes(member ...[]byte) SSremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c SremMember) Build() Completed {
 	return Completed(c)
 }
@@ -35998,6 +37258,16 @@ func (c STopkIncrbyItemsIncrement) Item(item string) STopkIncrbyItemsItem {
 	return (STopkIncrbyItemsItem)(c)
 }
 
+func (c TopkIncrbyItemsIncrement) ItemBytes(item []byte) TopkIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (TopkIncrbyItemsItem)(c)
+}
+
+func (c STopkIncrbyItemsIncrement) ItemBytes(item []byte) STopkIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (STopkIncrbyItemsItem)(c)
+}
+
 func (c TopkIncrbyItemsIncrement) Build() Completed {
 	return Completed(c)
 }
@@ -36034,6 +37304,16 @@ func (c STopkIncrbyKey) Item(item string) STopkIncrbyItemsItem {
 	return (STopkIncrbyItemsItem)(c)
 }
 
+func (c TopkIncrbyKey) ItemBytes(item []byte) TopkIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (TopkIncrbyItemsItem)(c)
+}
+
+func (c STopkIncrbyKey) ItemBytes(item []byte) STopkIncrbyItemsItem {
+	c.cs = append(c.cs, bstr(item))
+	return (STopkIncrbyItemsItem)(c)
+}
+
 type TopkInfo Completed
 
 type STopkInfo SCompleted
@@ -36185,6 +37465,20 @@ func (c STopkQueryItem) Item(item ...string) STopkQueryItem {
 	return c
 }
 
+func (c TopkQueryItem) ItemBytes(item ...[]byte) TopkQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c STopkQueryItem) ItemBytes(item ...[]byte) STopkQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c TopkQueryItem) Build() Completed {
 	return Completed(c)
 }
@@ -36215,6 +37509,20 @@ func (c STopkQueryKey) Item(item ...string) STopkQueryItem {
 	return (STopkQueryItem)(c)
 }
 
+func (c TopkQueryKey) ItemBytes(item ...[]byte) TopkQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (TopkQueryItem)(c)
+}
+
+func (c STopkQueryKey) ItemBytes(item ...[]byte) STopkQueryItem {
+	for _, n := range item {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (STopkQueryItem)(c)
+}
+
 type TopkReserve Completed
 
 type STopkReserve SCompleted
@@ -36687,6 +37995,16 @@ func (c STsAddLabels) Labels(label string, value string) STsAddLabels {
 	return c
 }
 
+func (c TsAddLabels) LabelsBytes(label string, value []byte) TsAddLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
+func (c STsAddLabels) LabelsBytes(label string, value []byte) STsAddLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
 func (c TsAddLabels) Build() Completed {
 	return Completed(c)
 }
@@ -37148,6 +38466,16 @@ func (c STsAlterLabels) Labels(label string, value string) STsAlterLabels {
 	return c
 }
 
+func (c TsAlterLabels) LabelsBytes(label string, value []byte) TsAlterLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
+func (c STsAlterLabels) LabelsBytes(label string, value []byte) STsAlterLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
 func (c TsAlterLabels) Build() Completed {
 	return Completed(c)
 }
@@ -37743,6 +39071,16 @@ func (c STsCreateLabels) Labels(label string, value string) STsCreateLabels {
 	return c
 }
 
+func (c TsCreateLabels) LabelsBytes(label string, value []byte) TsCreateLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
+func (c STsCreateLabels) LabelsBytes(label string, value []byte) STsCreateLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
 func (c TsCreateLabels) Build() Completed {
 	return Completed(c)
 }
@@ -38280,6 +39618,16 @@ func (c STsDecrbyLabels) Labels(label string, value string) STsDecrbyLabels {
 	return c
 }
 
+func (c TsDecrbyLabels) LabelsBytes(label string, value []byte) TsDecrbyLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
+func (c STsDecrbyLabels) LabelsBytes(label string, value []byte) STsDecrbyLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
 func (c TsDecrbyLabels) Build() Completed {
 	return Completed(c)
 }
@@ -38624,6 +39972,14 @@ func (c STsGetKey) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsGetKey) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsGetKey) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsIncrby Completed
 
 type STsIncrby SCompleted
@@ -38697,6 +40053,16 @@ func (c STsIncrbyLabels) Labels(label string, value string) STsIncrbyLabels {
 	return c
 }
 
+func (c TsIncrbyLabels) LabelsBytes(label string, value []byte) TsIncrbyLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
+func (c STsIncrbyLabels) LabelsBytes(label string, value []byte) STsIncrbyLabels {
+	c.cs = append(c.cs, label, bstr(value))
+	return c
+}
+
 func (c TsIncrbyLabels) Build() Completed {
 	return Completed(c)
 }
@@ -38928,6 +40294,14 @@ func (c STsInfoDebug) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsInfoDebug) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsInfoDebug) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsInfoKey Completed
 
 type STsInfoKey SCompleted
@@ -38950,6 +40324,14 @@ func (c STsInfoKey) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsInfoKey) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsInfoKey) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsMadd Completed
 
 type STsMadd SCompleted
@@ -42264,6 +43646,14 @@ func (c STsRangeAggregationTimebucket) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeAggregationTimebucket) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeAggregationTimebucket) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRangeAlign Completed
 
 type STsRangeAlign SCompleted
@@ -42396,6 +43786,14 @@ func (c STsRangeAlign) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeAlign) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeAlign) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRangeCount Completed
 
 type STsRangeCount SCompleted
@@ -42538,6 +43936,14 @@ func (c STsRangeCount) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeCount) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeCount) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRangeFilterByTs Completed
 
 type STsRangeFilterByTs SCompleted
@@ -42716,6 +44122,14 @@ func (c STsRangeFilterByTs) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeFilterByTs) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeFilterByTs) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRangeFilterByValue Completed
 
 type STsRangeFilterByValue SCompleted
@@ -42868,6 +44282,14 @@ func (c STsRangeFilterByValue) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeFilterByValue) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeFilterByValue) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRangeFromtimestamp Completed
 
 type STsRangeFromtimestamp SCompleted
@@ -43074,6 +44496,14 @@ func (c STsRangeTotimestamp) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRangeTotimestamp) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRangeTotimestamp) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrange Completed
 
 type STsRevrange SCompleted
@@ -43277,6 +44707,14 @@ func (c STsRevrangeAggregationTimebucket) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeAggregationTimebucket) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeAggregationTimebucket) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrangeAlign Completed
 
 type STsRevrangeAlign SCompleted
@@ -43409,6 +44847,14 @@ func (c STsRevrangeAlign) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeAlign) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeAlign) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrangeCount Completed
 
 type STsRevrangeCount SCompleted
@@ -43551,6 +44997,14 @@ func (c STsRevrangeCount) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeCount) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeCount) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrangeFilterByTs Completed
 
 type STsRevrangeFilterByTs SCompleted
@@ -43729,6 +45183,14 @@ func (c STsRevrangeFilterByTs) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeFilterByTs) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeFilterByTs) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrangeFilterByValue Completed
 
 type STsRevrangeFilterByValue SCompleted
@@ -43881,6 +45343,14 @@ func (c STsRevrangeFilterByValue) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeFilterByValue) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeFilterByValue) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type TsRevrangeFromtimestamp Completed
 
 type STsRevrangeFromtimestamp SCompleted
@@ -44087,6 +45557,14 @@ func (c STsRevrangeTotimestamp) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c TsRevrangeTotimestamp) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c STsRevrangeTotimestamp) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type Ttl Completed
 
 type STtl SCompleted
@@ -44503,6 +45981,16 @@ func (c SXaddFieldValue) FieldValue(field string, value string) SXaddFieldValue
 	return c
 }
 
+func (c XaddFieldValue) FieldValueBytes(field string, value []byte) XaddFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
+func (c SXaddFieldValue) FieldValueBytes(field string, value []byte) SXaddFieldValue {
+	c.cs = append(c.cs, field, bstr(value))
+	return c
+}
+
 func (c XaddFieldValue) Build() Completed {
 	return Completed(c)
 }
@@ -45811,6 +47299,14 @@ func (c SXlenKey) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c XlenKey) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SXlenKey) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type Xpending Completed
 
 type SXpending SCompleted
@@ -45981,6 +47477,14 @@ func (c SXrangeCount) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c XrangeCount) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SXrangeCount) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type XrangeEnd Completed
 
 type SXrangeEnd SCompleted
@@ -46003,6 +47507,14 @@ func (c SXrangeEnd) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c XrangeEnd) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SXrangeEnd) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type XrangeKey Completed
 
 type SXrangeKey SCompleted
@@ -46424,6 +47936,14 @@ func (c SXrevrangeCount) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c XrevrangeCount) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SXrevrangeCount) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type XrevrangeEnd Completed
 
 type SXrevrangeEnd SCompleted
@@ -46474,6 +47994,14 @@ func (c SXrevrangeStart) Build() SCompleted {
 	return SCompleted(c)
 }
 
+func (c XrevrangeStart) Cache() Cacheable {
+	return Cacheable(c)
+}
+
+func (c SXrevrangeStart) Cache() SCacheable {
+	return SCacheable(c)
+}
+
 type Xtrim Completed
 
 type SXtrim SCompleted
@@ -46962,6 +48490,16 @@ func (c SZaddScoreMember) ScoreMember(score float64, member string) SZaddScoreMe
 	return c
 }
 
+func (c ZaddScoreMember) ScoreMemberBytes(score float64, member []byte) ZaddScoreMember {
+	c.cs = append(c.cs, strconv.FormatFloat(score, 'f', -1, 64), bstr(member))
+	return c
+}
+
+func (c SZaddScoreMember) ScoreMemberBytes(score float64, member []byte) SZaddScoreMember {
+	c.cs = append(c.cs, strconv.FormatFloat(score, 'f', -1, 64), bstr(member))
+	return c
+}
+
 func (c ZaddScoreMember) Build() Completed {
 	return Completed(c)
 }
@@ -47286,6 +48824,16 @@ func (c SZincrbyIncrement) Member(member string) SZincrbyMember {
 	return (SZincrbyMember)(c)
 }
 
+func (c ZincrbyIncrement) MemberBytes(member []byte) ZincrbyMember {
+	c.cs = append(c.cs, bstr(member))
+	return (ZincrbyMember)(c)
+}
+
+func (c SZincrbyIncrement) MemberBytes(member []byte) SZincrbyMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SZincrbyMember)(c)
+}
+
 type ZincrbyKey Completed
 
 type SZincrbyKey SCompleted
@@ -48123,6 +49671,20 @@ func (c SZmscoreKey) Member(member ...string) SZmscoreMember {
 	return (SZmscoreMember)(c)
 }
 
+func (c ZmscoreKey) MemberBytes(member ...[]byte) ZmscoreMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (ZmscoreMember)(c)
+}
+
+func (c SZmscoreKey) MemberBytes(member ...[]byte) SZmscoreMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SZmscoreMember)(c)
+}
+
 type ZmscoreMember Completed
 
 type SZmscoreMember SCompleted
@@ -48137,6 +49699,20 @@ func (c SZmscoreMember) Member(member ...string) SZmscoreMember {
 	return c
 }
 
+func (c ZmscoreMember) MemberBytes(member ...[]byte) ZmscoreMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SZmscoreMember) MemberBytes(member ...[]byte) SZmscoreMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c ZmscoreMember) Build() Completed {
 	return Completed(c)
 }
@@ -48661,10 +50237,12 @@ type Zrangebylex Completed
 
 type SZrangebylex SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Zrangebylex() Zrangebylex {
 	return Zrangebylex{cs: append(b.get(), "ZRANGEBYLEX"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Zrangebylex() SZrangebylex {
 	return SZrangebylex{cs: append(b.get(), "ZRANGEBYLEX"), ks: InitSlot, cf: readonly}
 }
@@ -48762,10 +50340,12 @@ type Zrangebyscore Completed
 
 type SZrangebyscore SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Zrangebyscore() Zrangebyscore {
 	return Zrangebyscore{cs: append(b.get(), "ZRANGEBYSCORE"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Zrangebyscore() SZrangebyscore {
 	return SZrangebyscore{cs: append(b.get(), "ZRANGEBYSCORE"), ks: InitSlot, cf: readonly}
 }
@@ -49152,6 +50732,16 @@ func (c SZrankKey) Member(member string) SZrankMember {
 	return (SZrankMember)(c)
 }
 
+func (c ZrankKey) MemberBytes(member []byte) ZrankMember {
+	c.cs = append(c.cs, bstr(member))
+	return (ZrankMember)(c)
+}
+
+func (c SZrankKey) MemberBytes(member []byte) SZrankMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SZrankMember)(c)
+}
+
 type ZrankMember Completed
 
 type SZrankMember SCompleted
@@ -49209,6 +50799,20 @@ func (c SZremKey) Member(member ...string) SZremMember {
 	return (SZremMember)(c)
 }
 
+func (c ZremKey) MemberBytes(member ...[]byte) ZremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (ZremMember)(c)
+}
+
+func (c SZremKey) MemberBytes(member ...[]byte) SZremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return (SZremMember)(c)
+}
+
 type ZremMember Completed
 
 type SZremMember SCompleted
@@ -49223,6 +50827,20 @@ func (c SZremMember) Member(member ...string) SZremMember {
 	return c
 }
 
+func (c ZremMember) MemberBytes(member ...[]byte) ZremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
+func (c SZremMember) MemberBytes(member ...[]byte) SZremMember {
+	for _, n := range member {
+		c.cs = append(c.cs, bstr(n))
+	}
+	return c
+}
+
 func (c ZremMember) Build() Completed {
 	return Completed(c)
 }
@@ -49424,10 +51042,12 @@ type Zrevrange Completed
 
 type SZrevrange SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Zrevrange() Zrevrange {
 	return Zrevrange{cs: append(b.get(), "ZREVRANGE"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Zrevrange() SZrevrange {
 	return SZrevrange{cs: append(b.get(), "ZREVRANGE"), ks: InitSlot, cf: readonly}
 }
@@ -49525,10 +51145,12 @@ type Zrevrangebylex Completed
 
 type SZrevrangebylex SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Zrevrangebylex() Zrevrangebylex {
 	return Zrevrangebylex{cs: append(b.get(), "ZREVRANGEBYLEX"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Zrevrangebylex() SZrevrangebylex {
 	return SZrevrangebylex{cs: append(b.get(), "ZREVRANGEBYLEX"), ks: InitSlot, cf: readonly}
 }
@@ -49626,10 +51248,12 @@ type Zrevrangebyscore Completed
 
 type SZrevrangebyscore SCompleted
 
+// Deprecated: since 6.2.0
 func (b *Builder) Zrevrangebyscore() Zrevrangebyscore {
 	return Zrevrangebyscore{cs: append(b.get(), "ZREVRANGEBYSCORE"), ks: InitSlot, cf: readonly}
 }
 
+// Deprecated: since 6.2.0
 func (b *SBuilder) Zrevrangebyscore() SZrevrangebyscore {
 	return SZrevrangebyscore{cs: append(b.get(), "ZREVRANGEBYSCORE"), ks: InitSlot, cf: readonly}
 }
@@ -49800,6 +51424,16 @@ func (c SZrevrankKey) Member(member string) SZrevrankMember {
 	return (SZrevrankMember)(c)
 }
 
+func (c ZrevrankKey) MemberBytes(member []byte) ZrevrankMember {
+	c.cs = append(c.cs, bstr(member))
+	return (ZrevrankMember)(c)
+}
+
+func (c SZrevrankKey) MemberBytes(member []byte) SZrevrankMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SZrevrankMember)(c)
+}
+
 type ZrevrankMember Completed
 
 type SZrevrankMember SCompleted
@@ -49960,6 +51594,16 @@ func (c SZscoreKey) Member(member string) SZscoreMember {
 	return (SZscoreMember)(c)
 }
 
+func (c ZscoreKey) MemberBytes(member []byte) ZscoreMember {
+	c.cs = append(c.cs, bstr(member))
+	return (ZscoreMember)(c)
+}
+
+func (c SZscoreKey) MemberBytes(member []byte) SZscoreMember {
+	c.cs = append(c.cs, bstr(member))
+	return (SZscoreMember)(c)
+}
+
 type ZscoreMember Completed
 
 type SZscoreMember SCompleted
@@ -50465,3 +52109,437 @@ func (c SZunionstoreWeights) Build() SCompleted {
 	return SCompleted(c)
 }
 
+var metas = map[string]Meta{
+	"ACL CAT": {Name: "ACL CAT", Since: "6.0.0", Group: "server", Complexity: "O(1) since the categories and commands are a fixed set."},
+	"ACL DELUSER": {Name: "ACL DELUSER", Since: "6.0.0", Group: "server", Complexity: "O(1) amortized time considering the typical user."},
+	"ACL GENPASS": {Name: "ACL GENPASS", Since: "6.0.0", Group: "server", Complexity: "O(1)"},
+	"ACL GETUSER": {Name: "ACL GETUSER", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of password, command and pattern rules that the user has."},
+	"ACL HELP": {Name: "ACL HELP", Since: "6.0.0", Group: "server", Complexity: "O(1)"},
+	"ACL LIST": {Name: "ACL LIST", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of configured users."},
+	"ACL LOAD": {Name: "ACL LOAD", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of configured users."},
+	"ACL LOG": {Name: "ACL LOG", Since: "6.0.0", Group: "server", Complexity: "O(N) with N being the number of entries shown."},
+	"ACL SAVE": {Name: "ACL SAVE", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of configured users."},
+	"ACL SETUSER": {Name: "ACL SETUSER", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of rules provided."},
+	"ACL USERS": {Name: "ACL USERS", Since: "6.0.0", Group: "server", Complexity: "O(N). Where N is the number of configured users."},
+	"ACL WHOAMI": {Name: "ACL WHOAMI", Since: "6.0.0", Group: "server", Complexity: "O(1)"},
+	"APPEND": {Name: "APPEND", Since: "2.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1). The amortized time complexity is O(1) assuming the appended value is small and the already present value is of any size, since the dynamic string library used by Redis will double the free space available on every reallocation."},
+	"ASKING": {Name: "ASKING", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"AUTH": {Name: "AUTH", Since: "1.0.0", Group: "connection"},
+	"BF.ADD": {Name: "BF.ADD", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k), where k is the number of hash functions used by the last sub-filter"},
+	"BF.EXISTS": {Name: "BF.EXISTS", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k), where k is the number of hash functions used by the last sub-filter"},
+	"BF.INFO": {Name: "BF.INFO", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"BF.INSERT": {Name: "BF.INSERT", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k * n), where k is the number of hash functions and n is the number of items"},
+	"BF.LOADCHUNK": {Name: "BF.LOADCHUNK", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n), where n is the capacity"},
+	"BF.MADD": {Name: "BF.MADD", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k * n), where k is the number of hash functions and n is the number of items"},
+	"BF.MEXISTS": {Name: "BF.MEXISTS", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k * n), where k is the number of hash functions and n is the number of items"},
+	"BF.RESERVE": {Name: "BF.RESERVE", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"BF.SCANDUMP": {Name: "BF.SCANDUMP", Since: "1.0.0", Group: "bloom", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n), where n is the capacity"},
+	"BGREWRITEAOF": {Name: "BGREWRITEAOF", Since: "1.0.0", Group: "server"},
+	"BGSAVE": {Name: "BGSAVE", Since: "1.0.0", Group: "server"},
+	"BITCOUNT": {Name: "BITCOUNT", Since: "2.6.0", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N)"},
+	"BITFIELD": {Name: "BITFIELD", Since: "3.2.0", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each subcommand specified"},
+	"BITFIELD_RO": {Name: "BITFIELD_RO", Since: "6.2.0", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each subcommand specified"},
+	"BITOP": {Name: "BITOP", Since: "2.6.0", Group: "bitmap", FirstKey: 2, LastKey: -1, KeyStep: 1, Complexity: "O(N)"},
+	"BITPOS": {Name: "BITPOS", Since: "2.8.7", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N)"},
+	"BLMOVE": {Name: "BLMOVE", Since: "6.2.0", Group: "list", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"BLMPOP": {Name: "BLMPOP", Since: "7.0.0", Group: "list", MovableKeys: true, Complexity: "O(N+M) where N is the number of provided keys and M is the number of elements returned."},
+	"BLPOP": {Name: "BLPOP", Since: "2.0.0", Group: "list", FirstKey: 1, LastKey: -2, KeyStep: 1, Complexity: "O(N) where N is the number of provided keys."},
+	"BRPOP": {Name: "BRPOP", Since: "2.0.0", Group: "list", FirstKey: 1, LastKey: -2, KeyStep: 1, Complexity: "O(N) where N is the number of provided keys."},
+	"BRPOPLPUSH": {Name: "BRPOPLPUSH", Since: "2.2.0", Group: "list", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"BZMPOP": {Name: "BZMPOP", Since: "7.0.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(K) + O(N*log(M)) where K is the number of provided keys, N being the number of elements in the sorted set, and M being the number of elements popped."},
+	"BZPOPMAX": {Name: "BZPOPMAX", Since: "5.0.0", Group: "sorted_set", FirstKey: 1, LastKey: -2, KeyStep: 1, Complexity: "O(log(N)) with N being the number of elements in the sorted set."},
+	"BZPOPMIN": {Name: "BZPOPMIN", Since: "5.0.0", Group: "sorted_set", FirstKey: 1, LastKey: -2, KeyStep: 1, Complexity: "O(log(N)) with N being the number of elements in the sorted set."},
+	"CF.ADD": {Name: "CF.ADD", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k + i), where k is the number of sub-filters and i is maxIterations"},
+	"CF.ADDNX": {Name: "CF.ADDNX", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k + i), where k is the number of sub-filters and i is maxIterations"},
+	"CF.COUNT": {Name: "CF.COUNT", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k), where k is the number of sub-filters"},
+	"CF.DEL": {Name: "CF.DEL", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k), where k is the number of sub-filters"},
+	"CF.EXISTS": {Name: "CF.EXISTS", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k), where k is the number of sub-filters"},
+	"CF.INFO": {Name: "CF.INFO", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"CF.INSERT": {Name: "CF.INSERT", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n * (k + i)), where n is the number of items, k is the number of sub-filters and i is maxIterations"},
+	"CF.INSERTNX": {Name: "CF.INSERTNX", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n * (k + i)), where n is the number of items, k is the number of sub-filters and i is maxIterations"},
+	"CF.LOADCHUNK": {Name: "CF.LOADCHUNK", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n), where n is the capacity"},
+	"CF.MEXISTS": {Name: "CF.MEXISTS", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k * n), where k is the number of sub-filters and n is the number of items"},
+	"CF.RESERVE": {Name: "CF.RESERVE", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"CF.SCANDUMP": {Name: "CF.SCANDUMP", Since: "1.0.0", Group: "cuckoo", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n), where n is the capacity"},
+	"CLIENT CACHING": {Name: "CLIENT CACHING", Since: "6.0.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT GETNAME": {Name: "CLIENT GETNAME", Since: "2.6.9", Group: "connection", Complexity: "O(1)"},
+	"CLIENT GETREDIR": {Name: "CLIENT GETREDIR", Since: "6.0.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT ID": {Name: "CLIENT ID", Since: "5.0.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT INFO": {Name: "CLIENT INFO", Since: "6.2.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT KILL": {Name: "CLIENT KILL", Since: "2.4.0", Group: "connection", Complexity: "O(N) where N is the number of client connections"},
+	"CLIENT LIST": {Name: "CLIENT LIST", Since: "2.4.0", Group: "connection", Complexity: "O(N) where N is the number of client connections"},
+	"CLIENT NO-EVICT": {Name: "CLIENT NO-EVICT", Since: "7.0.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT PAUSE": {Name: "CLIENT PAUSE", Since: "2.9.50", Group: "connection", Complexity: "O(1)"},
+	"CLIENT REPLY": {Name: "CLIENT REPLY", Since: "3.2.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT SETNAME": {Name: "CLIENT SETNAME", Since: "2.6.9", Group: "connection", Complexity: "O(1)"},
+	"CLIENT TRACKING": {Name: "CLIENT TRACKING", Since: "6.0.0", Group: "connection", Complexity: "O(1). Some options may introduce additional complexity."},
+	"CLIENT TRACKINGINFO": {Name: "CLIENT TRACKINGINFO", Since: "6.2.0", Group: "connection", Complexity: "O(1)"},
+	"CLIENT UNBLOCK": {Name: "CLIENT UNBLOCK", Since: "5.0.0", Group: "connection", Complexity: "O(log N) where N is the number of client connections"},
+	"CLIENT UNPAUSE": {Name: "CLIENT UNPAUSE", Since: "6.2.0", Group: "connection", Complexity: "O(N) Where N is the number of paused clients"},
+	"CLUSTER ADDSLOTS": {Name: "CLUSTER ADDSLOTS", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the total number of hash slot arguments"},
+	"CLUSTER ADDSLOTSRANGE": {Name: "CLUSTER ADDSLOTSRANGE", Since: "7.0.0", Group: "cluster", Complexity: "O(N) where N is the total number of the slots between the start slot and end slot arguments."},
+	"CLUSTER BUMPEPOCH": {Name: "CLUSTER BUMPEPOCH", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER COUNT-FAILURE-REPORTS": {Name: "CLUSTER COUNT-FAILURE-REPORTS", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the number of failure reports"},
+	"CLUSTER COUNTKEYSINSLOT": {Name: "CLUSTER COUNTKEYSINSLOT", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER DELSLOTS": {Name: "CLUSTER DELSLOTS", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the total number of hash slot arguments"},
+	"CLUSTER DELSLOTSRANGE": {Name: "CLUSTER DELSLOTSRANGE", Since: "7.0.0", Group: "cluster", Complexity: "O(N) where N is the total number of the slots between the start slot and end slot arguments."},
+	"CLUSTER FAILOVER": {Name: "CLUSTER FAILOVER", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER FLUSHSLOTS": {Name: "CLUSTER FLUSHSLOTS", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER FORGET": {Name: "CLUSTER FORGET", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER GETKEYSINSLOT": {Name: "CLUSTER GETKEYSINSLOT", Since: "3.0.0", Group: "cluster", Complexity: "O(log(N)) where N is the number of requested keys"},
+	"CLUSTER INFO": {Name: "CLUSTER INFO", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER KEYSLOT": {Name: "CLUSTER KEYSLOT", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the number of bytes in the key"},
+	"CLUSTER MEET": {Name: "CLUSTER MEET", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER MYID": {Name: "CLUSTER MYID", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER NODES": {Name: "CLUSTER NODES", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the total number of Cluster nodes"},
+	"CLUSTER REPLICAS": {Name: "CLUSTER REPLICAS", Since: "5.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER REPLICATE": {Name: "CLUSTER REPLICATE", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER RESET": {Name: "CLUSTER RESET", Since: "3.0.0", Group: "cluster", Complexity: "O(N) where N is the number of known nodes. The command may execute a FLUSHALL as a side effect."},
+	"CLUSTER SAVECONFIG": {Name: "CLUSTER SAVECONFIG", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER SET-CONFIG-EPOCH": {Name: "CLUSTER SET-CONFIG-EPOCH", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER SETSLOT": {Name: "CLUSTER SETSLOT", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"CLUSTER SLAVES": {Name: "CLUSTER SLAVES", Since: "3.0.0", Group: "cluster", DeprecatedSince: "5.0.0", Complexity: "O(1)"},
+	"CLUSTER SLOTS": {Name: "CLUSTER SLOTS", Since: "3.0.0", Group: "cluster", DeprecatedSince: "7.0.0", Complexity: "O(N) where N is the total number of Cluster nodes"},
+	"CMS.INCRBY": {Name: "CMS.INCRBY", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n) where n is the number of items"},
+	"CMS.INFO": {Name: "CMS.INFO", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"CMS.INITBYDIM": {Name: "CMS.INITBYDIM", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"CMS.INITBYPROB": {Name: "CMS.INITBYPROB", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"CMS.MERGE": {Name: "CMS.MERGE", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Module: "bloom", Complexity: "O(n) where n is the number of sketches"},
+	"CMS.QUERY": {Name: "CMS.QUERY", Since: "2.0.0", Group: "cms", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n) where n is the number of items"},
+	"COMMAND": {Name: "COMMAND", Since: "2.8.13", Group: "server", Complexity: "O(N) where N is the total number of Redis commands"},
+	"COMMAND COUNT": {Name: "COMMAND COUNT", Since: "2.8.13", Group: "server", Complexity: "O(1)"},
+	"COMMAND GETKEYS": {Name: "COMMAND GETKEYS", Since: "2.8.13", Group: "server", Complexity: "O(N) where N is the number of arguments to the command"},
+	"COMMAND INFO": {Name: "COMMAND INFO", Since: "2.8.13", Group: "server", Complexity: "O(N) when N is number of commands to look up"},
+	"CONFIG GET": {Name: "CONFIG GET", Since: "2.0.0", Group: "server"},
+	"CONFIG RESETSTAT": {Name: "CONFIG RESETSTAT", Since: "2.0.0", Group: "server", Complexity: "O(1)"},
+	"CONFIG REWRITE": {Name: "CONFIG REWRITE", Since: "2.8.0", Group: "server"},
+	"CONFIG SET": {Name: "CONFIG SET", Since: "2.0.0", Group: "server"},
+	"COPY": {Name: "COPY", Since: "6.2.0", Group: "generic", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(N) worst case for collections, where N is the number of nested items. O(1) for string values."},
+	"DBSIZE": {Name: "DBSIZE", Since: "1.0.0", Group: "server"},
+	"DEBUG OBJECT": {Name: "DEBUG OBJECT", Since: "1.0.0", Group: "server", FirstKey: 2, LastKey: 2, KeyStep: 1},
+	"DEBUG SEGFAULT": {Name: "DEBUG SEGFAULT", Since: "1.0.0", Group: "server"},
+	"DECR": {Name: "DECR", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"DECRBY": {Name: "DECRBY", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"DEL": {Name: "DEL", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the number of keys that will be removed. When a key to remove holds a value other than a string, the individual complexity for this key is O(M) where M is the number of elements in the list, set, sorted set or hash. Removing a single key that holds a string value is O(1)."},
+	"DISCARD": {Name: "DISCARD", Since: "2.0.0", Group: "transactions"},
+	"DUMP": {Name: "DUMP", Since: "2.6.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) to access the key and additional O(N*M) to serialize it, where N is the number of Redis objects composing the value and M their average size. For small string values the time complexity is thus O(1)+O(1*M) where M is small, so simply O(1)."},
+	"ECHO": {Name: "ECHO", Since: "1.0.0", Group: "connection"},
+	"EVAL": {Name: "EVAL", Since: "2.6.0", Group: "scripting", MovableKeys: true, Complexity: "Depends on the script that is executed."},
+	"EVALSHA": {Name: "EVALSHA", Since: "2.6.0", Group: "scripting", MovableKeys: true, Complexity: "Depends on the script that is executed."},
+	"EVALSHA_RO": {Name: "EVALSHA_RO", Since: "7.0.0", Group: "scripting", MovableKeys: true, Complexity: "Depends on the script that is executed."},
+	"EVAL_RO": {Name: "EVAL_RO", Since: "7.0.0", Group: "scripting", MovableKeys: true, Complexity: "Depends on the script that is executed."},
+	"EXEC": {Name: "EXEC", Since: "1.2.0", Group: "transactions"},
+	"EXISTS": {Name: "EXISTS", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the number of keys to check."},
+	"EXPIRE": {Name: "EXPIRE", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"EXPIREAT": {Name: "EXPIREAT", Since: "1.2.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"EXPIRETIME": {Name: "EXPIRETIME", Since: "7.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"FAILOVER": {Name: "FAILOVER", Since: "6.2.0", Group: "server"},
+	"FLUSHALL": {Name: "FLUSHALL", Since: "1.0.0", Group: "server", Complexity: "O(N) where N is the total number of keys in all databases"},
+	"FLUSHDB": {Name: "FLUSHDB", Since: "1.0.0", Group: "server", Complexity: "O(N) where N is the number of keys in the selected database"},
+	"FT.AGGREGATE": {Name: "FT.AGGREGATE", Since: "1.1.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.ALIASADD": {Name: "FT.ALIASADD", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.ALIASDEL": {Name: "FT.ALIASDEL", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.ALIASUPDATE": {Name: "FT.ALIASUPDATE", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.ALTER": {Name: "FT.ALTER", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(N) where N is the number of keys in the keyspace"},
+	"FT.CONFIG GET": {Name: "FT.CONFIG GET", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.CONFIG HELP": {Name: "FT.CONFIG HELP", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.CONFIG SET": {Name: "FT.CONFIG SET", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.CREATE": {Name: "FT.CREATE", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(K) at creation where K is the number of fields, O(N) if scanning the keyspace is triggered, where N is the number of keys in the keyspace"},
+	"FT.CURSOR DEL": {Name: "FT.CURSOR DEL", Since: "1.1.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.CURSOR READ": {Name: "FT.CURSOR READ", Since: "1.1.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.DICTADD": {Name: "FT.DICTADD", Since: "1.4.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.DICTDEL": {Name: "FT.DICTDEL", Since: "1.4.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.DICTDUMP": {Name: "FT.DICTDUMP", Since: "1.4.0", Group: "search", Module: "search", Complexity: "O(N), where N is the size of the dictionary"},
+	"FT.DROPINDEX": {Name: "FT.DROPINDEX", Since: "2.0.0", Group: "search", Module: "search", Complexity: "O(1) or O(N) if documents are deleted, where N is the number of keys in the keyspace"},
+	"FT.EXPLAIN": {Name: "FT.EXPLAIN", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.EXPLAINCLI": {Name: "FT.EXPLAINCLI", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.INFO": {Name: "FT.INFO", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.SEARCH": {Name: "FT.SEARCH", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(N)"},
+	"FT.SPELLCHECK": {Name: "FT.SPELLCHECK", Since: "1.4.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.SUGADD": {Name: "FT.SUGADD", Since: "1.0.0", Group: "suggestion", Module: "search", Complexity: "O(1)"},
+	"FT.SUGDEL": {Name: "FT.SUGDEL", Since: "1.0.0", Group: "suggestion", Module: "search", Complexity: "O(1)"},
+	"FT.SUGGET": {Name: "FT.SUGGET", Since: "1.0.0", Group: "suggestion", Module: "search", Complexity: "O(1)"},
+	"FT.SUGLEN": {Name: "FT.SUGLEN", Since: "1.0.0", Group: "suggestion", Module: "search", Complexity: "O(1)"},
+	"FT.SYNDUMP": {Name: "FT.SYNDUMP", Since: "1.2.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.SYNUPDATE": {Name: "FT.SYNUPDATE", Since: "1.2.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"FT.TAGVALS": {Name: "FT.TAGVALS", Since: "1.0.0", Group: "search", Module: "search", Complexity: "O(N)"},
+	"FT._LIST": {Name: "FT._LIST", Since: "2.0.0", Group: "search", Module: "search", Complexity: "O(1)"},
+	"GEOADD": {Name: "GEOADD", Since: "3.2.0", Group: "geo", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) for each item added, where N is the number of elements in the sorted set."},
+	"GEODIST": {Name: "GEODIST", Since: "3.2.0", Group: "geo", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N))"},
+	"GEOHASH": {Name: "GEOHASH", Since: "3.2.0", Group: "geo", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) for each member requested, where N is the number of elements in the sorted set."},
+	"GEOPOS": {Name: "GEOPOS", Since: "3.2.0", Group: "geo", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of members requested."},
+	"GEORADIUS": {Name: "GEORADIUS", Since: "3.2.0", Group: "geo", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(N+log(M)) where N is the number of elements inside the bounding box of the circular area delimited by center and radius and M is the number of items inside the index."},
+	"GEORADIUSBYMEMBER": {Name: "GEORADIUSBYMEMBER", Since: "3.2.0", Group: "geo", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(N+log(M)) where N is the number of elements inside the bounding box of the circular area delimited by center and radius and M is the number of items inside the index."},
+	"GEORADIUSBYMEMBER_RO": {Name: "GEORADIUSBYMEMBER_RO", Since: "3.2.10", Group: "geo", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N+log(M)) where N is the number of elements inside the bounding box of the circular area delimited by center and radius and M is the number of items inside the index."},
+	"GEORADIUS_RO": {Name: "GEORADIUS_RO", Since: "3.2.10", Group: "geo", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N+log(M)) where N is the number of elements inside the bounding box of the circular area delimited by center and radius and M is the number of items inside the index."},
+	"GEOSEARCH": {Name: "GEOSEARCH", Since: "6.2", Group: "geo", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N+log(M)) where N is the number of elements in the grid-aligned bounding box area around the shape provided as the filter and M is the number of items inside the shape"},
+	"GEOSEARCHSTORE": {Name: "GEOSEARCHSTORE", Since: "6.2", Group: "geo", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(N+log(M)) where N is the number of elements in the grid-aligned bounding box area around the shape provided as the filter and M is the number of items inside the shape"},
+	"GET": {Name: "GET", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"GETBIT": {Name: "GETBIT", Since: "2.2.0", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"GETDEL": {Name: "GETDEL", Since: "6.2.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"GETEX": {Name: "GETEX", Since: "6.2.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"GETRANGE": {Name: "GETRANGE", Since: "2.4.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the length of the returned string. The complexity is ultimately determined by the returned length, but because creating a substring from an existing string is very cheap, it can be considered O(1) for small strings."},
+	"GETSET": {Name: "GETSET", Since: "1.0.0", Group: "string", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"GRAPH.CONFIG GET": {Name: "GRAPH.CONFIG GET", Since: "2.2.11", Group: "graph", Module: "graph"},
+	"GRAPH.CONFIG SET": {Name: "GRAPH.CONFIG SET", Since: "2.2.11", Group: "graph", Module: "graph"},
+	"GRAPH.DELETE": {Name: "GRAPH.DELETE", Since: "1.0.0", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"GRAPH.EXPLAIN": {Name: "GRAPH.EXPLAIN", Since: "2.0.0", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"GRAPH.LIST": {Name: "GRAPH.LIST", Since: "2.4.3", Group: "graph", Module: "graph"},
+	"GRAPH.PROFILE": {Name: "GRAPH.PROFILE", Since: "2.0.0", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"GRAPH.QUERY": {Name: "GRAPH.QUERY", Since: "1.0.0", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"GRAPH.RO_QUERY": {Name: "GRAPH.RO_QUERY", Since: "2.2.8", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"GRAPH.SLOWLOG": {Name: "GRAPH.SLOWLOG", Since: "2.0.12", Group: "graph", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "graph"},
+	"HDEL": {Name: "HDEL", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of fields to be removed."},
+	"HELLO": {Name: "HELLO", Since: "6.0.0", Group: "connection", Complexity: "O(1)"},
+	"HEXISTS": {Name: "HEXISTS", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HGET": {Name: "HGET", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HGETALL": {Name: "HGETALL", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the size of the hash."},
+	"HINCRBY": {Name: "HINCRBY", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HINCRBYFLOAT": {Name: "HINCRBYFLOAT", Since: "2.6.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HKEYS": {Name: "HKEYS", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the size of the hash."},
+	"HLEN": {Name: "HLEN", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HMGET": {Name: "HMGET", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of fields being requested."},
+	"HMSET": {Name: "HMSET", Since: "2.0.0", Group: "hash", DeprecatedSince: "4.0.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of fields being set."},
+	"HRANDFIELD": {Name: "HRANDFIELD", Since: "6.2.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of fields returned"},
+	"HSCAN": {Name: "HSCAN", Since: "2.8.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for every call. O(N) for a complete iteration, including enough command calls for the cursor to return back to 0. N is the number of elements inside the collection.."},
+	"HSET": {Name: "HSET", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each field/value pair added, so O(N) to add N field/value pairs when the command is called with multiple field/value pairs."},
+	"HSETNX": {Name: "HSETNX", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HSTRLEN": {Name: "HSTRLEN", Since: "3.2.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"HVALS": {Name: "HVALS", Since: "2.0.0", Group: "hash", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the size of the hash."},
+	"INCR": {Name: "INCR", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"INCRBY": {Name: "INCRBY", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"INCRBYFLOAT": {Name: "INCRBYFLOAT", Since: "2.6.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"INFO": {Name: "INFO", Since: "1.0.0", Group: "server"},
+	"JSON.ARRAPPEND": {Name: "JSON.ARRAPPEND", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.ARRINDEX": {Name: "JSON.ARRINDEX", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the array, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.ARRINSERT": {Name: "JSON.ARRINSERT", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the array, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.ARRLEN": {Name: "JSON.ARRLEN", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) where path is evaluated to a single value, O(N) where path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.ARRPOP": {Name: "JSON.ARRPOP", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the array and the specified index is not the last element, O(1) when path is evaluated to a single value and the specified index is the last element, or O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.ARRTRIM": {Name: "JSON.ARRTRIM", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the array, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.CLEAR": {Name: "JSON.CLEAR", Since: "2.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the values, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.DEL": {Name: "JSON.DEL", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the deleted value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.GET": {Name: "JSON.GET", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value where N is the size of the value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.MGET": {Name: "JSON.MGET", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: -2, KeyStep: 1, Module: "json", Complexity: "O(M*N) when path is evaluated to a single value where M is the number of keys and N is the size of the value, O(N1+N2+...+Nm) when path is evaluated to multiple values where m is the number of keys and Ni is the size of the i-th key"},
+	"JSON.NUMINCRBY": {Name: "JSON.NUMINCRBY", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.OBJKEYS": {Name: "JSON.OBJKEYS", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value, where N is the number of keys in the object, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.OBJLEN": {Name: "JSON.OBJLEN", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.RESP": {Name: "JSON.RESP", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(N) when path is evaluated to a single value, where N is the size of the value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.SET": {Name: "JSON.SET", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(M+N) when path is evaluated to a single value where M is the size of the original value (if it exists) and N is the size of the new value, O(M+N) when path is evaluated to multiple values where M is the size of the key and N is the size of the new value"},
+	"JSON.STRAPPEND": {Name: "JSON.STRAPPEND", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.STRLEN": {Name: "JSON.STRLEN", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.TOGGLE": {Name: "JSON.TOGGLE", Since: "2.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"JSON.TYPE": {Name: "JSON.TYPE", Since: "1.0.0", Group: "json", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "json", Complexity: "O(1) when path is evaluated to a single value, O(N) when path is evaluated to multiple values, where N is the size of the key"},
+	"KEYS": {Name: "KEYS", Since: "1.0.0", Group: "generic", Complexity: "O(N) with N being the number of keys in the database, under the assumption that the key names in the database and the given pattern have limited length."},
+	"LASTSAVE": {Name: "LASTSAVE", Since: "1.0.0", Group: "server"},
+	"LATENCY DOCTOR": {Name: "LATENCY DOCTOR", Since: "2.8.13", Group: "server"},
+	"LATENCY GRAPH": {Name: "LATENCY GRAPH", Since: "2.8.13", Group: "server"},
+	"LATENCY HELP": {Name: "LATENCY HELP", Since: "2.8.13", Group: "server"},
+	"LATENCY HISTORY": {Name: "LATENCY HISTORY", Since: "2.8.13", Group: "server"},
+	"LATENCY LATEST": {Name: "LATENCY LATEST", Since: "2.8.13", Group: "server"},
+	"LATENCY RESET": {Name: "LATENCY RESET", Since: "2.8.13", Group: "server"},
+	"LCS": {Name: "LCS", Since: "7.0.0", Group: "string", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(N*M) where N and M are the lengths of s1 and s2, respectively"},
+	"LINDEX": {Name: "LINDEX", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements to traverse to get to the element at index. This makes asking for the first or the last element of the list O(1)."},
+	"LINSERT": {Name: "LINSERT", Since: "2.2.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements to traverse before seeing the value pivot. This means that inserting somewhere on the left end on the list (head) can be considered O(1) and inserting somewhere on the right end (tail) is O(N)."},
+	"LLEN": {Name: "LLEN", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"LMOVE": {Name: "LMOVE", Since: "6.2.0", Group: "list", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"LMPOP": {Name: "LMPOP", Since: "7.0.0", Group: "list", MovableKeys: true, Complexity: "O(N+M) where N is the number of provided keys and M is the number of elements returned."},
+	"LOLWUT": {Name: "LOLWUT", Since: "5.0.0", Group: "server"},
+	"LPOP": {Name: "LPOP", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements returned"},
+	"LPOS": {Name: "LPOS", Since: "6.0.6", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements in the list, for the average case. When searching for elements near the head or the tail of the list, or when the MAXLEN option is provided, the command may run in constant time."},
+	"LPUSH": {Name: "LPUSH", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each element added, so O(N) to add N elements when the command is called with multiple arguments."},
+	"LPUSHX": {Name: "LPUSHX", Since: "2.2.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each element added, so O(N) to add N elements when the command is called with multiple arguments."},
+	"LRANGE": {Name: "LRANGE", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(S+N) where S is the distance of start offset from HEAD for small lists, from nearest end (HEAD or TAIL) for large lists; and N is the number of elements in the specified range."},
+	"LREM": {Name: "LREM", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N+M) where N is the length of the list and M is the number of elements removed."},
+	"LSET": {Name: "LSET", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the length of the list. Setting either the first or the last element of the list is O(1)."},
+	"LTRIM": {Name: "LTRIM", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements to be removed by the operation."},
+	"MEMORY DOCTOR": {Name: "MEMORY DOCTOR", Since: "4.0.0", Group: "server"},
+	"MEMORY HELP": {Name: "MEMORY HELP", Since: "4.0.0", Group: "server"},
+	"MEMORY MALLOC-STATS": {Name: "MEMORY MALLOC-STATS", Since: "4.0.0", Group: "server"},
+	"MEMORY PURGE": {Name: "MEMORY PURGE", Since: "4.0.0", Group: "server"},
+	"MEMORY STATS": {Name: "MEMORY STATS", Since: "4.0.0", Group: "server"},
+	"MEMORY USAGE": {Name: "MEMORY USAGE", Since: "4.0.0", Group: "server", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(N) where N is the number of samples."},
+	"MGET": {Name: "MGET", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the number of keys to retrieve."},
+	"MIGRATE": {Name: "MIGRATE", Since: "2.6.0", Group: "generic", FirstKey: 3, LastKey: 3, KeyStep: 1, MovableKeys: true, Complexity: "This command actually executes a DUMP+DEL in the source instance, and a RESTORE in the target instance. See the pages of these commands for time complexity. Also an O(N) data transfer between the two instances is performed."},
+	"MODULE LIST": {Name: "MODULE LIST", Since: "4.0.0", Group: "server", Complexity: "O(N) where N is the number of loaded modules."},
+	"MODULE LOAD": {Name: "MODULE LOAD", Since: "4.0.0", Group: "server", Complexity: "O(1)"},
+	"MODULE UNLOAD": {Name: "MODULE UNLOAD", Since: "4.0.0", Group: "server", Complexity: "O(1)"},
+	"MONITOR": {Name: "MONITOR", Since: "1.0.0", Group: "server"},
+	"MOVE": {Name: "MOVE", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"MSET": {Name: "MSET", Since: "1.0.1", Group: "string", FirstKey: 1, LastKey: -1, KeyStep: 2, Complexity: "O(N) where N is the number of keys to set."},
+	"MSETNX": {Name: "MSETNX", Since: "1.0.1", Group: "string", FirstKey: 1, LastKey: -1, KeyStep: 2, Complexity: "O(N) where N is the number of keys to set."},
+	"MULTI": {Name: "MULTI", Since: "1.2.0", Group: "transactions"},
+	"OBJECT ENCODING": {Name: "OBJECT ENCODING", Since: "2.2.3", Group: "generic", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"OBJECT FREQ": {Name: "OBJECT FREQ", Since: "4.0.0", Group: "generic", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"OBJECT HELP": {Name: "OBJECT HELP", Since: "6.2.0", Group: "generic", Complexity: "O(1)"},
+	"OBJECT IDLETIME": {Name: "OBJECT IDLETIME", Since: "2.2.3", Group: "generic", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"OBJECT REFCOUNT": {Name: "OBJECT REFCOUNT", Since: "2.2.3", Group: "generic", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"PERSIST": {Name: "PERSIST", Since: "2.2.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PEXPIRE": {Name: "PEXPIRE", Since: "2.6.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PEXPIREAT": {Name: "PEXPIREAT", Since: "2.6.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PEXPIRETIME": {Name: "PEXPIRETIME", Since: "7.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PFADD": {Name: "PFADD", Since: "2.8.9", Group: "hyperloglog", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) to add every element."},
+	"PFCOUNT": {Name: "PFCOUNT", Since: "2.8.9", Group: "hyperloglog", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(1) with a very small average constant time when called with a single key. O(N) with N being the number of keys, and much bigger constant times, when called with multiple keys."},
+	"PFMERGE": {Name: "PFMERGE", Since: "2.8.9", Group: "hyperloglog", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) to merge N HyperLogLogs, but with high constant times."},
+	"PING": {Name: "PING", Since: "1.0.0", Group: "connection"},
+	"PSETEX": {Name: "PSETEX", Since: "2.6.0", Group: "string", DeprecatedSince: "2.6.12", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PSUBSCRIBE": {Name: "PSUBSCRIBE", Since: "2.0.0", Group: "pubsub", Complexity: "O(N) where N is the number of patterns the client is already subscribed to."},
+	"PSYNC": {Name: "PSYNC", Since: "2.8.0", Group: "server"},
+	"PTTL": {Name: "PTTL", Since: "2.6.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"PUBLISH": {Name: "PUBLISH", Since: "2.0.0", Group: "pubsub", Complexity: "O(N+M) where N is the number of clients subscribed to the receiving channel and M is the total number of subscribed patterns (by any client)."},
+	"PUBSUB CHANNELS": {Name: "PUBSUB CHANNELS", Since: "2.8.0", Group: "pubsub", Complexity: "O(N) where N is the number of active channels, and assuming constant time pattern matching (relatively short channels and patterns)"},
+	"PUBSUB HELP": {Name: "PUBSUB HELP", Since: "6.2.0", Group: "pubsub", Complexity: "O(1)"},
+	"PUBSUB NUMPAT": {Name: "PUBSUB NUMPAT", Since: "2.8.0", Group: "pubsub", Complexity: "O(1)"},
+	"PUBSUB NUMSUB": {Name: "PUBSUB NUMSUB", Since: "2.8.0", Group: "pubsub", Complexity: "O(N) for the NUMSUB subcommand, where N is the number of requested channels"},
+	"PUNSUBSCRIBE": {Name: "PUNSUBSCRIBE", Since: "2.0.0", Group: "pubsub", Complexity: "O(N+M) where N is the number of patterns the client is already subscribed and M is the number of total patterns subscribed in the system (by any client)."},
+	"QUIT": {Name: "QUIT", Since: "1.0.0", Group: "connection"},
+	"RANDOMKEY": {Name: "RANDOMKEY", Since: "1.0.0", Group: "generic", Complexity: "O(1)"},
+	"READONLY": {Name: "READONLY", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"READWRITE": {Name: "READWRITE", Since: "3.0.0", Group: "cluster", Complexity: "O(1)"},
+	"RENAME": {Name: "RENAME", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"RENAMENX": {Name: "RENAMENX", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"REPLICAOF": {Name: "REPLICAOF", Since: "5.0.0", Group: "server"},
+	"RESET": {Name: "RESET", Since: "6.2", Group: "connection"},
+	"RESTORE": {Name: "RESTORE", Since: "2.6.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) to create the new key and additional O(N*M) to reconstruct the serialized value, where N is the number of Redis objects composing the value and M their average size. For small string values the time complexity is thus O(1)+O(1*M) where M is small, so simply O(1). However for sorted set values the complexity is O(N*M*log(N)) because inserting values into sorted sets is O(log(N))."},
+	"ROLE": {Name: "ROLE", Since: "2.8.12", Group: "server"},
+	"RPOP": {Name: "RPOP", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements returned"},
+	"RPOPLPUSH": {Name: "RPOPLPUSH", Since: "1.2.0", Group: "list", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"RPUSH": {Name: "RPUSH", Since: "1.0.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each element added, so O(N) to add N elements when the command is called with multiple arguments."},
+	"RPUSHX": {Name: "RPUSHX", Since: "2.2.0", Group: "list", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each element added, so O(N) to add N elements when the command is called with multiple arguments."},
+	"SADD": {Name: "SADD", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each element added, so O(N) to add N elements when the command is called with multiple arguments."},
+	"SAVE": {Name: "SAVE", Since: "1.0.0", Group: "server"},
+	"SCAN": {Name: "SCAN", Since: "2.8.0", Group: "generic", Complexity: "O(1) for every call. O(N) for a complete iteration, including enough command calls for the cursor to return back to 0. N is the number of elements inside the collection."},
+	"SCARD": {Name: "SCARD", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SCRIPT DEBUG": {Name: "SCRIPT DEBUG", Since: "3.2.0", Group: "scripting", Complexity: "O(1)"},
+	"SCRIPT EXISTS": {Name: "SCRIPT EXISTS", Since: "2.6.0", Group: "scripting", Complexity: "O(N) with N being the number of scripts to check (so checking a single script is an O(1) operation)."},
+	"SCRIPT FLUSH": {Name: "SCRIPT FLUSH", Since: "2.6.0", Group: "scripting", Complexity: "O(N) with N being the number of scripts in cache"},
+	"SCRIPT KILL": {Name: "SCRIPT KILL", Since: "2.6.0", Group: "scripting", Complexity: "O(1)"},
+	"SCRIPT LOAD": {Name: "SCRIPT LOAD", Since: "2.6.0", Group: "scripting", Complexity: "O(N) with N being the length in bytes of the script body."},
+	"SDIFF": {Name: "SDIFF", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the total number of elements in all given sets."},
+	"SDIFFSTORE": {Name: "SDIFFSTORE", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the total number of elements in all given sets."},
+	"SELECT": {Name: "SELECT", Since: "1.0.0", Group: "connection"},
+	"SET": {Name: "SET", Since: "1.0.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SETBIT": {Name: "SETBIT", Since: "2.2.0", Group: "bitmap", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SETEX": {Name: "SETEX", Since: "2.0.0", Group: "string", DeprecatedSince: "2.6.12", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SETNX": {Name: "SETNX", Since: "1.0.0", Group: "string", DeprecatedSince: "2.6.12", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SETRANGE": {Name: "SETRANGE", Since: "2.2.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1), not counting the time taken to copy the new string in place. Usually, this string is very small so the amortized complexity is O(1). Otherwise, complexity is O(M) with M being the length of the value argument."},
+	"SHUTDOWN": {Name: "SHUTDOWN", Since: "1.0.0", Group: "server"},
+	"SINTER": {Name: "SINTER", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N*M) worst case where N is the cardinality of the smallest set and M is the number of sets."},
+	"SINTERCARD": {Name: "SINTERCARD", Since: "7.0.0", Group: "set", MovableKeys: true, Complexity: "O(N*M) worst case where N is the cardinality of the smallest set and M is the number of sets."},
+	"SINTERSTORE": {Name: "SINTERSTORE", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N*M) worst case where N is the cardinality of the smallest set and M is the number of sets."},
+	"SISMEMBER": {Name: "SISMEMBER", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SLAVEOF": {Name: "SLAVEOF", Since: "1.0.0", Group: "server", DeprecatedSince: "5.0.0"},
+	"SLOWLOG GET": {Name: "SLOWLOG GET", Since: "2.2.12", Group: "server", Complexity: "O(N) where N is the number of entries returned"},
+	"SLOWLOG HELP": {Name: "SLOWLOG HELP", Since: "6.2.0", Group: "server", Complexity: "O(1)"},
+	"SLOWLOG LEN": {Name: "SLOWLOG LEN", Since: "2.2.12", Group: "server", Complexity: "O(1)"},
+	"SLOWLOG RESET": {Name: "SLOWLOG RESET", Since: "2.2.12", Group: "server", Complexity: "O(N) where N is the number of entries in the slowlog"},
+	"SMEMBERS": {Name: "SMEMBERS", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the set cardinality."},
+	"SMISMEMBER": {Name: "SMISMEMBER", Since: "6.2.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements being checked for membership"},
+	"SMOVE": {Name: "SMOVE", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"SORT": {Name: "SORT", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(N+M*log(M)) where N is the number of elements in the list or set to sort, and M the number of returned elements. When the elements are not sorted, complexity is O(N)."},
+	"SORT_RO": {Name: "SORT_RO", Since: "7.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N+M*log(M)) where N is the number of elements in the list or set to sort, and M the number of returned elements. When the elements are not sorted, complexity is O(N)."},
+	"SPOP": {Name: "SPOP", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "Without the count argument O(1), otherwise O(N) where N is the value of the passed count."},
+	"SRANDMEMBER": {Name: "SRANDMEMBER", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "Without the count argument O(1), otherwise O(N) where N is the absolute value of the passed count."},
+	"SREM": {Name: "SREM", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of members to be removed."},
+	"SSCAN": {Name: "SSCAN", Since: "2.8.0", Group: "set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for every call. O(N) for a complete iteration, including enough command calls for the cursor to return back to 0. N is the number of elements inside the collection.."},
+	"STRLEN": {Name: "STRLEN", Since: "2.2.0", Group: "string", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"SUBSCRIBE": {Name: "SUBSCRIBE", Since: "2.0.0", Group: "pubsub", Complexity: "O(N) where N is the number of channels to subscribe to."},
+	"SUNION": {Name: "SUNION", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the total number of elements in all given sets."},
+	"SUNIONSTORE": {Name: "SUNIONSTORE", Since: "1.0.0", Group: "set", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the total number of elements in all given sets."},
+	"SWAPDB": {Name: "SWAPDB", Since: "4.0.0", Group: "server", Complexity: "O(N) where N is the count of clients watching or blocking on keys from both databases."},
+	"SYNC": {Name: "SYNC", Since: "1.0.0", Group: "server"},
+	"TDIGEST.ADD": {Name: "TDIGEST.ADD", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(N) , where N is the number of samples to add"},
+	"TDIGEST.CDF": {Name: "TDIGEST.CDF", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.CREATE": {Name: "TDIGEST.CREATE", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.INFO": {Name: "TDIGEST.INFO", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.MAX": {Name: "TDIGEST.MAX", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.MERGE": {Name: "TDIGEST.MERGE", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 2, KeyStep: 1, Module: "bloom", Complexity: "O(N), where N is the number of centroids"},
+	"TDIGEST.MIN": {Name: "TDIGEST.MIN", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.QUANTILE": {Name: "TDIGEST.QUANTILE", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TDIGEST.RESET": {Name: "TDIGEST.RESET", Since: "2.4.0", Group: "tdigest", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TIME": {Name: "TIME", Since: "2.6.0", Group: "server", Complexity: "O(1)"},
+	"TOPK.ADD": {Name: "TOPK.ADD", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n * k) where n is the number of items and k is the depth"},
+	"TOPK.INCRBY": {Name: "TOPK.INCRBY", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n * k * incr) where n is the number of items, k is the depth and incr is the increment"},
+	"TOPK.INFO": {Name: "TOPK.INFO", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TOPK.LIST": {Name: "TOPK.LIST", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(k) where k is the value of top-k"},
+	"TOPK.QUERY": {Name: "TOPK.QUERY", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(n) where n is the number of items"},
+	"TOPK.RESERVE": {Name: "TOPK.RESERVE", Since: "2.0.0", Group: "topk", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "bloom", Complexity: "O(1)"},
+	"TOUCH": {Name: "TOUCH", Since: "3.2.1", Group: "generic", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(N) where N is the number of keys that will be touched."},
+	"TS.ADD": {Name: "TS.ADD", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(M) when M is the amount of compaction rules or O(1) with no compaction"},
+	"TS.ALTER": {Name: "TS.ALTER", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(N) where N is the number of labels requested to update"},
+	"TS.CREATE": {Name: "TS.CREATE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(1)"},
+	"TS.CREATERULE": {Name: "TS.CREATERULE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 2, KeyStep: 1, Module: "timeseries", Complexity: "O(1)"},
+	"TS.DECRBY": {Name: "TS.DECRBY", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(M) when M is the amount of compaction rules or O(1) with no compaction"},
+	"TS.DEL": {Name: "TS.DEL", Since: "1.6.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(N) where N is the number of data points that will be removed"},
+	"TS.DELETERULE": {Name: "TS.DELETERULE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 2, KeyStep: 1, Module: "timeseries", Complexity: "O(1)"},
+	"TS.GET": {Name: "TS.GET", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(1)"},
+	"TS.INCRBY": {Name: "TS.INCRBY", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(M) when M is the amount of compaction rules or O(1) with no compaction"},
+	"TS.INFO": {Name: "TS.INFO", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(1)"},
+	"TS.MADD": {Name: "TS.MADD", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: -1, KeyStep: 3, Module: "timeseries", Complexity: "O(N*M) when N is the amount of series updated and M is the amount of compaction rules or O(N) with no compaction"},
+	"TS.MGET": {Name: "TS.MGET", Since: "1.0.0", Group: "timeseries", Module: "timeseries", Complexity: "O(n) where n is the number of time-series that match the filters"},
+	"TS.MRANGE": {Name: "TS.MRANGE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(n/m+k) where n = Number of data points, m = Chunk size (data points per chunk), k = Number of data points that are in the requested ranges"},
+	"TS.MREVRANGE": {Name: "TS.MREVRANGE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(n/m+k) where n = Number of data points, m = Chunk size (data points per chunk), k = Number of data points that are in the requested ranges"},
+	"TS.QUERYINDEX": {Name: "TS.QUERYINDEX", Since: "1.0.0", Group: "timeseries", Module: "timeseries", Complexity: "O(n) where n is the number of time-series that match the filters"},
+	"TS.RANGE": {Name: "TS.RANGE", Since: "1.0.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(n/m+k) where n = Number of data points, m = Chunk size (data points per chunk), k = Number of data points that are in the requested range"},
+	"TS.REVRANGE": {Name: "TS.REVRANGE", Since: "1.4.0", Group: "timeseries", FirstKey: 1, LastKey: 1, KeyStep: 1, Module: "timeseries", Complexity: "O(n/m+k) where n = Number of data points, m = Chunk size (data points per chunk), k = Number of data points that are in the requested range"},
+	"TTL": {Name: "TTL", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"TYPE": {Name: "TYPE", Since: "1.0.0", Group: "generic", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"UNLINK": {Name: "UNLINK", Since: "4.0.0", Group: "generic", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(1) for each key removed regardless of its size. Then the command does O(N) work in a different thread in order to reclaim memory, where N is the number of allocations the deleted objects where composed of."},
+	"UNSUBSCRIBE": {Name: "UNSUBSCRIBE", Since: "2.0.0", Group: "pubsub", Complexity: "O(N) where N is the number of clients already subscribed to a channel."},
+	"UNWATCH": {Name: "UNWATCH", Since: "2.2.0", Group: "transactions", Complexity: "O(1)"},
+	"WAIT": {Name: "WAIT", Since: "3.0.0", Group: "generic", Complexity: "O(1)"},
+	"WATCH": {Name: "WATCH", Since: "2.2.0", Group: "transactions", FirstKey: 1, LastKey: -1, KeyStep: 1, Complexity: "O(1) for every key."},
+	"XACK": {Name: "XACK", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each message ID processed."},
+	"XADD": {Name: "XADD", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) when adding a new entry, O(N) when trimming where N being the number of entries evicted."},
+	"XAUTOCLAIM": {Name: "XAUTOCLAIM", Since: "6.2.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) if COUNT is small."},
+	"XCLAIM": {Name: "XCLAIM", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log N) with N being the number of messages in the PEL of the consumer group."},
+	"XDEL": {Name: "XDEL", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for each single item to delete in the stream, regardless of the stream size."},
+	"XGROUP CREATE": {Name: "XGROUP CREATE", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XGROUP CREATECONSUMER": {Name: "XGROUP CREATECONSUMER", Since: "6.2.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XGROUP DELCONSUMER": {Name: "XGROUP DELCONSUMER", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XGROUP DESTROY": {Name: "XGROUP DESTROY", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(N) where N is the number of entries in the group's pending entries list (PEL)."},
+	"XGROUP HELP": {Name: "XGROUP HELP", Since: "5.0.0", Group: "stream", Complexity: "O(1)"},
+	"XGROUP SETID": {Name: "XGROUP SETID", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XINFO CONSUMERS": {Name: "XINFO CONSUMERS", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XINFO GROUPS": {Name: "XINFO GROUPS", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XINFO HELP": {Name: "XINFO HELP", Since: "5.0.0", Group: "stream", Complexity: "O(1)"},
+	"XINFO STREAM": {Name: "XINFO STREAM", Since: "5.0.0", Group: "stream", FirstKey: 2, LastKey: 2, KeyStep: 1, Complexity: "O(1)"},
+	"XLEN": {Name: "XLEN", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"XPENDING": {Name: "XPENDING", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) with N being the number of elements returned, so asking for a small fixed number of entries per call is O(1). O(M), where M is the total number of entries scanned when used with the IDLE filter. When the command returns just the summary and the list of consumers is small, it runs in O(1) time; otherwise, an additional O(N) time for iterating every consumer."},
+	"XRANGE": {Name: "XRANGE", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) with N being the number of elements being returned. If N is constant (e.g. always asking for the first 10 elements with COUNT), you can consider it O(1)."},
+	"XREAD": {Name: "XREAD", Since: "5.0.0", Group: "stream", MovableKeys: true, Complexity: "For each stream mentioned: O(N) with N being the number of elements being returned, it means that XREAD-ing with a fixed COUNT is O(1). Note that when the BLOCK option is used, XADD will pay O(M) time in order to serve the M clients blocked on the stream getting new data."},
+	"XREADGROUP": {Name: "XREADGROUP", Since: "5.0.0", Group: "stream", MovableKeys: true, Complexity: "For each stream mentioned: O(M) with M being the number of elements returned. If M is constant (e.g. always asking for the first 10 elements with COUNT), you can consider it O(1). On the other side when XREADGROUP blocks, XADD will pay the O(N) time in order to serve the N clients blocked on the stream getting new data."},
+	"XREVRANGE": {Name: "XREVRANGE", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) with N being the number of elements returned. If N is constant (e.g. always asking for the first 10 elements with COUNT), you can consider it O(1)."},
+	"XTRIM": {Name: "XTRIM", Since: "5.0.0", Group: "stream", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N), with N being the number of evicted entries. Constant times are very small however, since entries are organized in macro nodes containing multiple entries that can be released with a single deallocation."},
+	"ZADD": {Name: "ZADD", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) for each item added, where N is the number of elements in the sorted set."},
+	"ZCARD": {Name: "ZCARD", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"ZCOUNT": {Name: "ZCOUNT", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) with N being the number of elements in the sorted set."},
+	"ZDIFF": {Name: "ZDIFF", Since: "6.2.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(L + (N-K)log(N)) worst case where L is the total number of elements in all the sets, N is the size of the first set, and K is the size of the result set."},
+	"ZDIFFSTORE": {Name: "ZDIFFSTORE", Since: "6.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(L + (N-K)log(N)) worst case where L is the total number of elements in all the sets, N is the size of the first set, and K is the size of the result set."},
+	"ZINCRBY": {Name: "ZINCRBY", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) where N is the number of elements in the sorted set."},
+	"ZINTER": {Name: "ZINTER", Since: "6.2.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(N*K)+O(M*log(M)) worst case with N being the smallest input sorted set, K being the number of input sorted sets and M being the number of elements in the resulting sorted set."},
+	"ZINTERCARD": {Name: "ZINTERCARD", Since: "7.0.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(N*K) worst case with N being the smallest input sorted set, K being the number of input sorted sets."},
+	"ZINTERSTORE": {Name: "ZINTERSTORE", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(N*K)+O(M*log(M)) worst case with N being the smallest input sorted set, K being the number of input sorted sets and M being the number of elements in the resulting sorted set."},
+	"ZLEXCOUNT": {Name: "ZLEXCOUNT", Since: "2.8.9", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)) with N being the number of elements in the sorted set."},
+	"ZMPOP": {Name: "ZMPOP", Since: "7.0.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(K) + O(N*log(M)) where K is the number of provided keys, N being the number of elements in the sorted set, and M being the number of elements popped."},
+	"ZMSCORE": {Name: "ZMSCORE", Since: "6.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of members being requested."},
+	"ZPOPMAX": {Name: "ZPOPMAX", Since: "5.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)*M) with N being the number of elements in the sorted set, and M being the number of elements popped."},
+	"ZPOPMIN": {Name: "ZPOPMIN", Since: "5.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)*M) with N being the number of elements in the sorted set, and M being the number of elements popped."},
+	"ZRANDMEMBER": {Name: "ZRANDMEMBER", Since: "6.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(N) where N is the number of elements returned"},
+	"ZRANGE": {Name: "ZRANGE", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements returned."},
+	"ZRANGEBYLEX": {Name: "ZRANGEBYLEX", Since: "2.8.9", Group: "sorted_set", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements being returned. If M is constant (e.g. always asking for the first 10 elements with LIMIT), you can consider it O(log(N))."},
+	"ZRANGEBYSCORE": {Name: "ZRANGEBYSCORE", Since: "1.0.5", Group: "sorted_set", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements being returned. If M is constant (e.g. always asking for the first 10 elements with LIMIT), you can consider it O(log(N))."},
+	"ZRANGESTORE": {Name: "ZRANGESTORE", Since: "6.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 2, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements stored into the destination key."},
+	"ZRANK": {Name: "ZRANK", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N))"},
+	"ZREM": {Name: "ZREM", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(M*log(N)) with N being the number of elements in the sorted set and M the number of elements to be removed."},
+	"ZREMRANGEBYLEX": {Name: "ZREMRANGEBYLEX", Since: "2.8.9", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements removed by the operation."},
+	"ZREMRANGEBYRANK": {Name: "ZREMRANGEBYRANK", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements removed by the operation."},
+	"ZREMRANGEBYSCORE": {Name: "ZREMRANGEBYSCORE", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements removed by the operation."},
+	"ZREVRANGE": {Name: "ZREVRANGE", Since: "1.2.0", Group: "sorted_set", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements returned."},
+	"ZREVRANGEBYLEX": {Name: "ZREVRANGEBYLEX", Since: "2.8.9", Group: "sorted_set", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements being returned. If M is constant (e.g. always asking for the first 10 elements with LIMIT), you can consider it O(log(N))."},
+	"ZREVRANGEBYSCORE": {Name: "ZREVRANGEBYSCORE", Since: "2.2.0", Group: "sorted_set", DeprecatedSince: "6.2.0", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N)+M) with N being the number of elements in the sorted set and M the number of elements being returned. If M is constant (e.g. always asking for the first 10 elements with LIMIT), you can consider it O(log(N))."},
+	"ZREVRANK": {Name: "ZREVRANK", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(log(N))"},
+	"ZSCAN": {Name: "ZSCAN", Since: "2.8.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1) for every call. O(N) for a complete iteration, including enough command calls for the cursor to return back to 0. N is the number of elements inside the collection.."},
+	"ZSCORE": {Name: "ZSCORE", Since: "1.2.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, Complexity: "O(1)"},
+	"ZUNION": {Name: "ZUNION", Since: "6.2.0", Group: "sorted_set", MovableKeys: true, Complexity: "O(N)+O(M*log(M)) with N being the sum of the sizes of the input sorted sets, and M being the number of elements in the resulting sorted set."},
+	"ZUNIONSTORE": {Name: "ZUNIONSTORE", Since: "2.0.0", Group: "sorted_set", FirstKey: 1, LastKey: 1, KeyStep: 1, MovableKeys: true, Complexity: "O(N)+O(M log(M)) with N being the sum of the sizes of the input sorted sets, and M being the number of elements in the resulting sorted set."},
+}
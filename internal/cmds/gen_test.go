@@ -2,7 +2,10 @@
 
 package cmds
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 var s = NewBuilder()
 var c = NewSBuilder()
@@ -116,6 +119,8 @@ func TestCommand_0(t *testing.T) {
 	c.BfMadd().Key("1").Item("1").Item("1").Build()
 	s.BfMexists().Key("1").Item("1").Item("1").Build()
 	c.BfMexists().Key("1").Item("1").Item("1").Build()
+	s.BfMexists().Key("1").Item("1").Item("1").Cache()
+	c.BfMexists().Key("1").Item("1").Item("1").Cache()
 	s.BfReserve().Key("1").ErrorRate(1).Capacity(1).Expansion(1).Nonscaling().Build()
 	c.BfReserve().Key("1").ErrorRate(1).Capacity(1).Expansion(1).Nonscaling().Build()
 	s.BfReserve().Key("1").ErrorRate(1).Capacity(1).Expansion(1).Build()
@@ -126,6 +131,8 @@ func TestCommand_0(t *testing.T) {
 	c.BfReserve().Key("1").ErrorRate(1).Capacity(1).Build()
 	s.BfScandump().Key("1").Iterator(1).Build()
 	c.BfScandump().Key("1").Iterator(1).Build()
+	s.BfScandump().Key("1").Iterator(1).Cache()
+	c.BfScandump().Key("1").Iterator(1).Cache()
 	s.Bgrewriteaof().Build()
 	c.Bgrewriteaof().Build()
 	s.Bgsave().Schedule().Build()
@@ -331,6 +338,8 @@ func TestCommand_1(t *testing.T) {
 	c.CfReserve().Key("1").Capacity(1).Build()
 	s.CfScandump().Key("1").Iterator(1).Build()
 	c.CfScandump().Key("1").Iterator(1).Build()
+	s.CfScandump().Key("1").Iterator(1).Cache()
+	c.CfScandump().Key("1").Iterator(1).Cache()
 	s.ClientCaching().Yes().Build()
 	c.ClientCaching().Yes().Build()
 	s.ClientCaching().No().Build()
@@ -1418,6 +1427,8 @@ func TestCommand_6(t *testing.T) {
 	c.Discard().Build()
 	s.Dump().Key("1").Build()
 	c.Dump().Key("1").Build()
+	s.Dump().Key("1").Cache()
+	c.Dump().Key("1").Cache()
 	s.Echo().Message("1").Build()
 	c.Echo().Message("1").Build()
 	s.Eval().Script("1").Numkeys(1).Key("1").Key("1").Arg("1").Arg("1").Build()
@@ -15364,6 +15375,8 @@ func TestCommand_66(t *testing.T) {
 	c.GraphDelete().Graph("1").Build()
 	s.GraphExplain().Graph("1").Query("1").Build()
 	c.GraphExplain().Graph("1").Query("1").Build()
+	s.GraphExplain().Graph("1").Query("1").Cache()
+	c.GraphExplain().Graph("1").Query("1").Cache()
 	s.GraphList().Build()
 	c.GraphList().Build()
 	s.GraphProfile().Graph("1").Query("1").Build()
@@ -17242,6 +17255,8 @@ func TestCommand_74(t *testing.T) {
 	c.TsDeleterule().Sourcekey("1").Destkey("1").Build()
 	s.TsGet().Key("1").Build()
 	c.TsGet().Key("1").Build()
+	s.TsGet().Key("1").Cache()
+	c.TsGet().Key("1").Cache()
 	s.TsIncrby().Key("1").Value(1).Timestamp(1).Retention(1).Uncompressed().ChunkSize(1).Build()
 	c.TsIncrby().Key("1").Value(1).Timestamp(1).Retention(1).Uncompressed().ChunkSize(1).Build()
 	s.TsIncrby().Key("1").Value(1).Timestamp(1).Retention(1).Uncompressed().Labels().Labels("1", "1").Labels("1", "1").Build()
@@ -17306,8 +17321,12 @@ func TestCommand_74(t *testing.T) {
 	c.TsIncrby().Key("1").Value(1).Build()
 	s.TsInfo().Key("1").Debug("1").Build()
 	c.TsInfo().Key("1").Debug("1").Build()
+	s.TsInfo().Key("1").Debug("1").Cache()
+	c.TsInfo().Key("1").Debug("1").Cache()
 	s.TsInfo().Key("1").Build()
 	c.TsInfo().Key("1").Build()
+	s.TsInfo().Key("1").Cache()
+	c.TsInfo().Key("1").Cache()
 	s.TsMadd().KeyTimestampValue().KeyTimestampValue("1", 1, 1).KeyTimestampValue("1", 1, 1).Build()
 	c.TsMadd().KeyTimestampValue().KeyTimestampValue("1", 1, 1).KeyTimestampValue("1", 1, 1).Build()
 	s.TsMget().Withlabels().Filter("1").Filter("1").Build()
@@ -17483,310 +17502,610 @@ func TestCommand_75(t *testing.T) {
 	c.TsQueryindex().Filter("1").Filter("1").Build()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Cache()
 }
 
 func TestCommand_76(t *testing.T) {
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Build()
 	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Build()
+	s.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Cache()
+	c.TsRange().Key("1").Fromtimestamp(1).Totimestamp(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Count(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).FilterByValue(1, 1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Count(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByTs(1).FilterByTs(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Count(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationSum().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMin().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationMax().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationRange().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationCount().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationLast().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).FilterByValue(1, 1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Count(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationStdS().Timebucket(1).Cache()
 }
 
 func TestCommand_77(t *testing.T) {
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Align(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationAvg().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationSum().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMin().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationMax().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationRange().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationCount().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationFirst().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationLast().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationStdS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarP().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).AggregationVarS().Timebucket(1).Cache()
 	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Build()
 	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Build()
+	s.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Cache()
+	c.TsRevrange().Key("1").Fromtimestamp(1).Totimestamp(1).Cache()
 	s.Ttl().Key("1").Build()
 	c.Ttl().Key("1").Build()
 	s.Ttl().Key("1").Cache()
@@ -17881,14 +18200,20 @@ func TestCommand_77(t *testing.T) {
 	c.XinfoStream().Key("1").Build()
 	s.Xlen().Key("1").Build()
 	c.Xlen().Key("1").Build()
+	s.Xlen().Key("1").Cache()
+	c.Xlen().Key("1").Cache()
 	s.Xpending().Key("1").Group("1").Idle(1).Start("1").End("1").Count(1).Build()
 	c.Xpending().Key("1").Group("1").Idle(1).Start("1").End("1").Count(1).Build()
 	s.Xpending().Key("1").Group("1").Build()
 	c.Xpending().Key("1").Group("1").Build()
 	s.Xrange().Key("1").Start("1").End("1").Count(1).Build()
 	c.Xrange().Key("1").Start("1").End("1").Count(1).Build()
+	s.Xrange().Key("1").Start("1").End("1").Count(1).Cache()
+	c.Xrange().Key("1").Start("1").End("1").Count(1).Cache()
 	s.Xrange().Key("1").Start("1").End("1").Build()
 	c.Xrange().Key("1").Start("1").End("1").Build()
+	s.Xrange().Key("1").Start("1").End("1").Cache()
+	c.Xrange().Key("1").Start("1").End("1").Cache()
 	s.Xread().Count(1).Block(1).Streams().Key("1").Key("1").Id("1").Id("1").Build()
 	c.Xread().Count(1).Block(1).Streams().Key("1").Key("1").Id("1").Id("1").Build()
 	s.Xread().Count(1).Streams().Key("1").Key("1").Id("1").Id("1").Build()
@@ -17913,8 +18238,12 @@ func TestCommand_77(t *testing.T) {
 	c.Xreadgroup().Group("1", "1").Streams().Key("1").Key("1").Id("1").Id("1").Build()
 	s.Xrevrange().Key("1").End("1").Start("1").Count(1).Build()
 	c.Xrevrange().Key("1").End("1").Start("1").Count(1).Build()
+	s.Xrevrange().Key("1").End("1").Start("1").Count(1).Cache()
+	c.Xrevrange().Key("1").End("1").Start("1").Count(1).Cache()
 	s.Xrevrange().Key("1").End("1").Start("1").Build()
 	c.Xrevrange().Key("1").End("1").Start("1").Build()
+	s.Xrevrange().Key("1").End("1").Start("1").Cache()
+	c.Xrevrange().Key("1").End("1").Start("1").Cache()
 	s.Xtrim().Key("1").Maxlen().Exact().Threshold("1").Limit(1).Build()
 	c.Xtrim().Key("1").Maxlen().Exact().Threshold("1").Limit(1).Build()
 	s.Xtrim().Key("1").Maxlen().Exact().Threshold("1").Build()
@@ -18357,3 +18686,3297 @@ func TestCommand_79(t *testing.T) {
 	c.Zunionstore().Destination("1").Numkeys(1).Key("1").Key("1").Build()
 }
 
+func TestCommandFlags(t *testing.T) {
+	if v := s.AclCat().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL CAT %v", v)
+	}
+	if v := c.AclCat().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL CAT %v", v)
+	}
+	if v := s.AclDeluser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL DELUSER %v", v)
+	}
+	if v := c.AclDeluser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL DELUSER %v", v)
+	}
+	if v := s.AclGenpass().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL GENPASS %v", v)
+	}
+	if v := c.AclGenpass().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL GENPASS %v", v)
+	}
+	if v := s.AclGetuser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL GETUSER %v", v)
+	}
+	if v := c.AclGetuser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL GETUSER %v", v)
+	}
+	if v := s.AclHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL HELP %v", v)
+	}
+	if v := c.AclHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL HELP %v", v)
+	}
+	if v := s.AclList().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LIST %v", v)
+	}
+	if v := c.AclList().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LIST %v", v)
+	}
+	if v := s.AclLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LOAD %v", v)
+	}
+	if v := c.AclLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LOAD %v", v)
+	}
+	if v := s.AclLog().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LOG %v", v)
+	}
+	if v := c.AclLog().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL LOG %v", v)
+	}
+	if v := s.AclSave().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL SAVE %v", v)
+	}
+	if v := c.AclSave().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL SAVE %v", v)
+	}
+	if v := s.AclSetuser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL SETUSER %v", v)
+	}
+	if v := c.AclSetuser().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL SETUSER %v", v)
+	}
+	if v := s.AclUsers().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL USERS %v", v)
+	}
+	if v := c.AclUsers().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL USERS %v", v)
+	}
+	if v := s.AclWhoami().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL WHOAMI %v", v)
+	}
+	if v := c.AclWhoami().cf; v != 0 {
+		t.Fatalf("unexpected flags of ACL WHOAMI %v", v)
+	}
+	if v := s.Append().cf; v != 0 {
+		t.Fatalf("unexpected flags of APPEND %v", v)
+	}
+	if v := c.Append().cf; v != 0 {
+		t.Fatalf("unexpected flags of APPEND %v", v)
+	}
+	if v := s.Asking().cf; v != 0 {
+		t.Fatalf("unexpected flags of ASKING %v", v)
+	}
+	if v := c.Asking().cf; v != 0 {
+		t.Fatalf("unexpected flags of ASKING %v", v)
+	}
+	if v := s.Auth().cf; v != 0 {
+		t.Fatalf("unexpected flags of AUTH %v", v)
+	}
+	if v := c.Auth().cf; v != 0 {
+		t.Fatalf("unexpected flags of AUTH %v", v)
+	}
+	if v := s.BfAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.ADD %v", v)
+	}
+	if v := c.BfAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.ADD %v", v)
+	}
+	if v := s.BfExists().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.EXISTS %v", v)
+	}
+	if v := c.BfExists().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.EXISTS %v", v)
+	}
+	if v := s.BfInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.INFO %v", v)
+	}
+	if v := c.BfInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.INFO %v", v)
+	}
+	if v := s.BfInsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.INSERT %v", v)
+	}
+	if v := c.BfInsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.INSERT %v", v)
+	}
+	if v := s.BfLoadchunk().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.LOADCHUNK %v", v)
+	}
+	if v := c.BfLoadchunk().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.LOADCHUNK %v", v)
+	}
+	if v := s.BfMadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.MADD %v", v)
+	}
+	if v := c.BfMadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.MADD %v", v)
+	}
+	if v := s.BfMexists().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.MEXISTS %v", v)
+	}
+	if v := c.BfMexists().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.MEXISTS %v", v)
+	}
+	if v := s.BfReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.RESERVE %v", v)
+	}
+	if v := c.BfReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of BF.RESERVE %v", v)
+	}
+	if v := s.BfScandump().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.SCANDUMP %v", v)
+	}
+	if v := c.BfScandump().cf; v != readonly {
+		t.Fatalf("unexpected flags of BF.SCANDUMP %v", v)
+	}
+	if v := s.Bgrewriteaof().cf; v != 0 {
+		t.Fatalf("unexpected flags of BGREWRITEAOF %v", v)
+	}
+	if v := c.Bgrewriteaof().cf; v != 0 {
+		t.Fatalf("unexpected flags of BGREWRITEAOF %v", v)
+	}
+	if v := s.Bgsave().cf; v != 0 {
+		t.Fatalf("unexpected flags of BGSAVE %v", v)
+	}
+	if v := c.Bgsave().cf; v != 0 {
+		t.Fatalf("unexpected flags of BGSAVE %v", v)
+	}
+	if v := s.Bitcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITCOUNT %v", v)
+	}
+	if v := c.Bitcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITCOUNT %v", v)
+	}
+	if v := s.Bitfield().cf; v != 0 {
+		t.Fatalf("unexpected flags of BITFIELD %v", v)
+	}
+	if v := c.Bitfield().cf; v != 0 {
+		t.Fatalf("unexpected flags of BITFIELD %v", v)
+	}
+	if v := s.BitfieldRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITFIELD_RO %v", v)
+	}
+	if v := c.BitfieldRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITFIELD_RO %v", v)
+	}
+	if v := s.Bitop().cf; v != 0 {
+		t.Fatalf("unexpected flags of BITOP %v", v)
+	}
+	if v := c.Bitop().cf; v != 0 {
+		t.Fatalf("unexpected flags of BITOP %v", v)
+	}
+	if v := s.Bitpos().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITPOS %v", v)
+	}
+	if v := c.Bitpos().cf; v != readonly {
+		t.Fatalf("unexpected flags of BITPOS %v", v)
+	}
+	if v := s.Blmove().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLMOVE %v", v)
+	}
+	if v := c.Blmove().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLMOVE %v", v)
+	}
+	if v := s.Blmpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLMPOP %v", v)
+	}
+	if v := c.Blmpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLMPOP %v", v)
+	}
+	if v := s.Blpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLPOP %v", v)
+	}
+	if v := c.Blpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BLPOP %v", v)
+	}
+	if v := s.Brpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BRPOP %v", v)
+	}
+	if v := c.Brpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BRPOP %v", v)
+	}
+	if v := s.Brpoplpush().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BRPOPLPUSH %v", v)
+	}
+	if v := c.Brpoplpush().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BRPOPLPUSH %v", v)
+	}
+	if v := s.Bzmpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZMPOP %v", v)
+	}
+	if v := c.Bzmpop().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZMPOP %v", v)
+	}
+	if v := s.Bzpopmax().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZPOPMAX %v", v)
+	}
+	if v := c.Bzpopmax().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZPOPMAX %v", v)
+	}
+	if v := s.Bzpopmin().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZPOPMIN %v", v)
+	}
+	if v := c.Bzpopmin().cf; v != blockTag {
+		t.Fatalf("unexpected flags of BZPOPMIN %v", v)
+	}
+	if v := s.CfAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.ADD %v", v)
+	}
+	if v := c.CfAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.ADD %v", v)
+	}
+	if v := s.CfAddnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.ADDNX %v", v)
+	}
+	if v := c.CfAddnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.ADDNX %v", v)
+	}
+	if v := s.CfCount().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.COUNT %v", v)
+	}
+	if v := c.CfCount().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.COUNT %v", v)
+	}
+	if v := s.CfDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.DEL %v", v)
+	}
+	if v := c.CfDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.DEL %v", v)
+	}
+	if v := s.CfExists().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.EXISTS %v", v)
+	}
+	if v := c.CfExists().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.EXISTS %v", v)
+	}
+	if v := s.CfInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.INFO %v", v)
+	}
+	if v := c.CfInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.INFO %v", v)
+	}
+	if v := s.CfInsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.INSERT %v", v)
+	}
+	if v := c.CfInsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.INSERT %v", v)
+	}
+	if v := s.CfInsertnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.INSERTNX %v", v)
+	}
+	if v := c.CfInsertnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.INSERTNX %v", v)
+	}
+	if v := s.CfLoadchunk().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.LOADCHUNK %v", v)
+	}
+	if v := c.CfLoadchunk().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.LOADCHUNK %v", v)
+	}
+	if v := s.CfMexists().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.MEXISTS %v", v)
+	}
+	if v := c.CfMexists().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.MEXISTS %v", v)
+	}
+	if v := s.CfReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.RESERVE %v", v)
+	}
+	if v := c.CfReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of CF.RESERVE %v", v)
+	}
+	if v := s.CfScandump().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.SCANDUMP %v", v)
+	}
+	if v := c.CfScandump().cf; v != readonly {
+		t.Fatalf("unexpected flags of CF.SCANDUMP %v", v)
+	}
+	if v := s.ClientCaching().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT CACHING %v", v)
+	}
+	if v := c.ClientCaching().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT CACHING %v", v)
+	}
+	if v := s.ClientGetname().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT GETNAME %v", v)
+	}
+	if v := c.ClientGetname().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT GETNAME %v", v)
+	}
+	if v := s.ClientGetredir().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT GETREDIR %v", v)
+	}
+	if v := c.ClientGetredir().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT GETREDIR %v", v)
+	}
+	if v := s.ClientId().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT ID %v", v)
+	}
+	if v := c.ClientId().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT ID %v", v)
+	}
+	if v := s.ClientInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT INFO %v", v)
+	}
+	if v := c.ClientInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT INFO %v", v)
+	}
+	if v := s.ClientKill().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT KILL %v", v)
+	}
+	if v := c.ClientKill().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT KILL %v", v)
+	}
+	if v := s.ClientList().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT LIST %v", v)
+	}
+	if v := c.ClientList().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT LIST %v", v)
+	}
+	if v := s.ClientNoEvict().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT NO-EVICT %v", v)
+	}
+	if v := c.ClientNoEvict().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT NO-EVICT %v", v)
+	}
+	if v := s.ClientPause().cf; v != blockTag {
+		t.Fatalf("unexpected flags of CLIENT PAUSE %v", v)
+	}
+	if v := c.ClientPause().cf; v != blockTag {
+		t.Fatalf("unexpected flags of CLIENT PAUSE %v", v)
+	}
+	if v := s.ClientReply().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT REPLY %v", v)
+	}
+	if v := c.ClientReply().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT REPLY %v", v)
+	}
+	if v := s.ClientSetname().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT SETNAME %v", v)
+	}
+	if v := c.ClientSetname().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT SETNAME %v", v)
+	}
+	if v := s.ClientTracking().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT TRACKING %v", v)
+	}
+	if v := c.ClientTracking().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT TRACKING %v", v)
+	}
+	if v := s.ClientTrackinginfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT TRACKINGINFO %v", v)
+	}
+	if v := c.ClientTrackinginfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT TRACKINGINFO %v", v)
+	}
+	if v := s.ClientUnblock().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT UNBLOCK %v", v)
+	}
+	if v := c.ClientUnblock().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT UNBLOCK %v", v)
+	}
+	if v := s.ClientUnpause().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT UNPAUSE %v", v)
+	}
+	if v := c.ClientUnpause().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLIENT UNPAUSE %v", v)
+	}
+	if v := s.ClusterAddslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER ADDSLOTS %v", v)
+	}
+	if v := c.ClusterAddslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER ADDSLOTS %v", v)
+	}
+	if v := s.ClusterAddslotsrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER ADDSLOTSRANGE %v", v)
+	}
+	if v := c.ClusterAddslotsrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER ADDSLOTSRANGE %v", v)
+	}
+	if v := s.ClusterBumpepoch().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER BUMPEPOCH %v", v)
+	}
+	if v := c.ClusterBumpepoch().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER BUMPEPOCH %v", v)
+	}
+	if v := s.ClusterCountFailureReports().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER COUNT-FAILURE-REPORTS %v", v)
+	}
+	if v := c.ClusterCountFailureReports().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER COUNT-FAILURE-REPORTS %v", v)
+	}
+	if v := s.ClusterCountkeysinslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER COUNTKEYSINSLOT %v", v)
+	}
+	if v := c.ClusterCountkeysinslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER COUNTKEYSINSLOT %v", v)
+	}
+	if v := s.ClusterDelslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER DELSLOTS %v", v)
+	}
+	if v := c.ClusterDelslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER DELSLOTS %v", v)
+	}
+	if v := s.ClusterDelslotsrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER DELSLOTSRANGE %v", v)
+	}
+	if v := c.ClusterDelslotsrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER DELSLOTSRANGE %v", v)
+	}
+	if v := s.ClusterFailover().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FAILOVER %v", v)
+	}
+	if v := c.ClusterFailover().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FAILOVER %v", v)
+	}
+	if v := s.ClusterFlushslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FLUSHSLOTS %v", v)
+	}
+	if v := c.ClusterFlushslots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FLUSHSLOTS %v", v)
+	}
+	if v := s.ClusterForget().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FORGET %v", v)
+	}
+	if v := c.ClusterForget().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER FORGET %v", v)
+	}
+	if v := s.ClusterGetkeysinslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER GETKEYSINSLOT %v", v)
+	}
+	if v := c.ClusterGetkeysinslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER GETKEYSINSLOT %v", v)
+	}
+	if v := s.ClusterInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER INFO %v", v)
+	}
+	if v := c.ClusterInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER INFO %v", v)
+	}
+	if v := s.ClusterKeyslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER KEYSLOT %v", v)
+	}
+	if v := c.ClusterKeyslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER KEYSLOT %v", v)
+	}
+	if v := s.ClusterMeet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER MEET %v", v)
+	}
+	if v := c.ClusterMeet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER MEET %v", v)
+	}
+	if v := s.ClusterMyid().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER MYID %v", v)
+	}
+	if v := c.ClusterMyid().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER MYID %v", v)
+	}
+	if v := s.ClusterNodes().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER NODES %v", v)
+	}
+	if v := c.ClusterNodes().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER NODES %v", v)
+	}
+	if v := s.ClusterReplicas().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER REPLICAS %v", v)
+	}
+	if v := c.ClusterReplicas().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER REPLICAS %v", v)
+	}
+	if v := s.ClusterReplicate().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER REPLICATE %v", v)
+	}
+	if v := c.ClusterReplicate().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER REPLICATE %v", v)
+	}
+	if v := s.ClusterReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER RESET %v", v)
+	}
+	if v := c.ClusterReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER RESET %v", v)
+	}
+	if v := s.ClusterSaveconfig().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SAVECONFIG %v", v)
+	}
+	if v := c.ClusterSaveconfig().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SAVECONFIG %v", v)
+	}
+	if v := s.ClusterSetConfigEpoch().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SET-CONFIG-EPOCH %v", v)
+	}
+	if v := c.ClusterSetConfigEpoch().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SET-CONFIG-EPOCH %v", v)
+	}
+	if v := s.ClusterSetslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SETSLOT %v", v)
+	}
+	if v := c.ClusterSetslot().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SETSLOT %v", v)
+	}
+	if v := s.ClusterSlaves().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SLAVES %v", v)
+	}
+	if v := c.ClusterSlaves().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SLAVES %v", v)
+	}
+	if v := s.ClusterSlots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SLOTS %v", v)
+	}
+	if v := c.ClusterSlots().cf; v != 0 {
+		t.Fatalf("unexpected flags of CLUSTER SLOTS %v", v)
+	}
+	if v := s.CmsIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INCRBY %v", v)
+	}
+	if v := c.CmsIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INCRBY %v", v)
+	}
+	if v := s.CmsInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of CMS.INFO %v", v)
+	}
+	if v := c.CmsInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of CMS.INFO %v", v)
+	}
+	if v := s.CmsInitbydim().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INITBYDIM %v", v)
+	}
+	if v := c.CmsInitbydim().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INITBYDIM %v", v)
+	}
+	if v := s.CmsInitbyprob().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INITBYPROB %v", v)
+	}
+	if v := c.CmsInitbyprob().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.INITBYPROB %v", v)
+	}
+	if v := s.CmsMerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.MERGE %v", v)
+	}
+	if v := c.CmsMerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of CMS.MERGE %v", v)
+	}
+	if v := s.CmsQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of CMS.QUERY %v", v)
+	}
+	if v := c.CmsQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of CMS.QUERY %v", v)
+	}
+	if v := s.Command().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND %v", v)
+	}
+	if v := c.Command().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND %v", v)
+	}
+	if v := s.CommandCount().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND COUNT %v", v)
+	}
+	if v := c.CommandCount().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND COUNT %v", v)
+	}
+	if v := s.CommandGetkeys().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND GETKEYS %v", v)
+	}
+	if v := c.CommandGetkeys().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND GETKEYS %v", v)
+	}
+	if v := s.CommandInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND INFO %v", v)
+	}
+	if v := c.CommandInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of COMMAND INFO %v", v)
+	}
+	if v := s.ConfigGet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG GET %v", v)
+	}
+	if v := c.ConfigGet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG GET %v", v)
+	}
+	if v := s.ConfigResetstat().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG RESETSTAT %v", v)
+	}
+	if v := c.ConfigResetstat().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG RESETSTAT %v", v)
+	}
+	if v := s.ConfigRewrite().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG REWRITE %v", v)
+	}
+	if v := c.ConfigRewrite().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG REWRITE %v", v)
+	}
+	if v := s.ConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG SET %v", v)
+	}
+	if v := c.ConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of CONFIG SET %v", v)
+	}
+	if v := s.Copy().cf; v != 0 {
+		t.Fatalf("unexpected flags of COPY %v", v)
+	}
+	if v := c.Copy().cf; v != 0 {
+		t.Fatalf("unexpected flags of COPY %v", v)
+	}
+	if v := s.Dbsize().cf; v != readonly {
+		t.Fatalf("unexpected flags of DBSIZE %v", v)
+	}
+	if v := c.Dbsize().cf; v != readonly {
+		t.Fatalf("unexpected flags of DBSIZE %v", v)
+	}
+	if v := s.DebugObject().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEBUG OBJECT %v", v)
+	}
+	if v := c.DebugObject().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEBUG OBJECT %v", v)
+	}
+	if v := s.DebugSegfault().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEBUG SEGFAULT %v", v)
+	}
+	if v := c.DebugSegfault().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEBUG SEGFAULT %v", v)
+	}
+	if v := s.Decr().cf; v != 0 {
+		t.Fatalf("unexpected flags of DECR %v", v)
+	}
+	if v := c.Decr().cf; v != 0 {
+		t.Fatalf("unexpected flags of DECR %v", v)
+	}
+	if v := s.Decrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of DECRBY %v", v)
+	}
+	if v := c.Decrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of DECRBY %v", v)
+	}
+	if v := s.Del().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEL %v", v)
+	}
+	if v := c.Del().cf; v != 0 {
+		t.Fatalf("unexpected flags of DEL %v", v)
+	}
+	if v := s.Discard().cf; v != 0 {
+		t.Fatalf("unexpected flags of DISCARD %v", v)
+	}
+	if v := c.Discard().cf; v != 0 {
+		t.Fatalf("unexpected flags of DISCARD %v", v)
+	}
+	if v := s.Dump().cf; v != readonly {
+		t.Fatalf("unexpected flags of DUMP %v", v)
+	}
+	if v := c.Dump().cf; v != readonly {
+		t.Fatalf("unexpected flags of DUMP %v", v)
+	}
+	if v := s.Echo().cf; v != 0 {
+		t.Fatalf("unexpected flags of ECHO %v", v)
+	}
+	if v := c.Echo().cf; v != 0 {
+		t.Fatalf("unexpected flags of ECHO %v", v)
+	}
+	if v := s.Eval().cf; v != 0 {
+		t.Fatalf("unexpected flags of EVAL %v", v)
+	}
+	if v := c.Eval().cf; v != 0 {
+		t.Fatalf("unexpected flags of EVAL %v", v)
+	}
+	if v := s.EvalRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of EVAL_RO %v", v)
+	}
+	if v := c.EvalRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of EVAL_RO %v", v)
+	}
+	if v := s.Evalsha().cf; v != 0 {
+		t.Fatalf("unexpected flags of EVALSHA %v", v)
+	}
+	if v := c.Evalsha().cf; v != 0 {
+		t.Fatalf("unexpected flags of EVALSHA %v", v)
+	}
+	if v := s.EvalshaRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of EVALSHA_RO %v", v)
+	}
+	if v := c.EvalshaRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of EVALSHA_RO %v", v)
+	}
+	if v := s.Exec().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXEC %v", v)
+	}
+	if v := c.Exec().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXEC %v", v)
+	}
+	if v := s.Exists().cf; v != readonly {
+		t.Fatalf("unexpected flags of EXISTS %v", v)
+	}
+	if v := c.Exists().cf; v != readonly {
+		t.Fatalf("unexpected flags of EXISTS %v", v)
+	}
+	if v := s.Expire().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXPIRE %v", v)
+	}
+	if v := c.Expire().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXPIRE %v", v)
+	}
+	if v := s.Expireat().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXPIREAT %v", v)
+	}
+	if v := c.Expireat().cf; v != 0 {
+		t.Fatalf("unexpected flags of EXPIREAT %v", v)
+	}
+	if v := s.Expiretime().cf; v != readonly {
+		t.Fatalf("unexpected flags of EXPIRETIME %v", v)
+	}
+	if v := c.Expiretime().cf; v != readonly {
+		t.Fatalf("unexpected flags of EXPIRETIME %v", v)
+	}
+	if v := s.Failover().cf; v != 0 {
+		t.Fatalf("unexpected flags of FAILOVER %v", v)
+	}
+	if v := c.Failover().cf; v != 0 {
+		t.Fatalf("unexpected flags of FAILOVER %v", v)
+	}
+	if v := s.Flushall().cf; v != 0 {
+		t.Fatalf("unexpected flags of FLUSHALL %v", v)
+	}
+	if v := c.Flushall().cf; v != 0 {
+		t.Fatalf("unexpected flags of FLUSHALL %v", v)
+	}
+	if v := s.Flushdb().cf; v != 0 {
+		t.Fatalf("unexpected flags of FLUSHDB %v", v)
+	}
+	if v := c.Flushdb().cf; v != 0 {
+		t.Fatalf("unexpected flags of FLUSHDB %v", v)
+	}
+	if v := s.FtAggregate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.AGGREGATE %v", v)
+	}
+	if v := c.FtAggregate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.AGGREGATE %v", v)
+	}
+	if v := s.FtAliasadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASADD %v", v)
+	}
+	if v := c.FtAliasadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASADD %v", v)
+	}
+	if v := s.FtAliasdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASDEL %v", v)
+	}
+	if v := c.FtAliasdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASDEL %v", v)
+	}
+	if v := s.FtAliasupdate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASUPDATE %v", v)
+	}
+	if v := c.FtAliasupdate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALIASUPDATE %v", v)
+	}
+	if v := s.FtAlter().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALTER %v", v)
+	}
+	if v := c.FtAlter().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.ALTER %v", v)
+	}
+	if v := s.FtConfigGet().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG GET %v", v)
+	}
+	if v := c.FtConfigGet().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG GET %v", v)
+	}
+	if v := s.FtConfigHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG HELP %v", v)
+	}
+	if v := c.FtConfigHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG HELP %v", v)
+	}
+	if v := s.FtConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG SET %v", v)
+	}
+	if v := c.FtConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CONFIG SET %v", v)
+	}
+	if v := s.FtCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CREATE %v", v)
+	}
+	if v := c.FtCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CREATE %v", v)
+	}
+	if v := s.FtCursorDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CURSOR DEL %v", v)
+	}
+	if v := c.FtCursorDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CURSOR DEL %v", v)
+	}
+	if v := s.FtCursorRead().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CURSOR READ %v", v)
+	}
+	if v := c.FtCursorRead().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.CURSOR READ %v", v)
+	}
+	if v := s.FtDictadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTADD %v", v)
+	}
+	if v := c.FtDictadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTADD %v", v)
+	}
+	if v := s.FtDictdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTDEL %v", v)
+	}
+	if v := c.FtDictdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTDEL %v", v)
+	}
+	if v := s.FtDictdump().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTDUMP %v", v)
+	}
+	if v := c.FtDictdump().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DICTDUMP %v", v)
+	}
+	if v := s.FtDropindex().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DROPINDEX %v", v)
+	}
+	if v := c.FtDropindex().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.DROPINDEX %v", v)
+	}
+	if v := s.FtExplain().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.EXPLAIN %v", v)
+	}
+	if v := c.FtExplain().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.EXPLAIN %v", v)
+	}
+	if v := s.FtExplaincli().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.EXPLAINCLI %v", v)
+	}
+	if v := c.FtExplaincli().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.EXPLAINCLI %v", v)
+	}
+	if v := s.FtInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.INFO %v", v)
+	}
+	if v := c.FtInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.INFO %v", v)
+	}
+	if v := s.FtList().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT._LIST %v", v)
+	}
+	if v := c.FtList().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT._LIST %v", v)
+	}
+	if v := s.FtSearch().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SEARCH %v", v)
+	}
+	if v := c.FtSearch().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SEARCH %v", v)
+	}
+	if v := s.FtSpellcheck().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SPELLCHECK %v", v)
+	}
+	if v := c.FtSpellcheck().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SPELLCHECK %v", v)
+	}
+	if v := s.FtSugadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGADD %v", v)
+	}
+	if v := c.FtSugadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGADD %v", v)
+	}
+	if v := s.FtSugdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGDEL %v", v)
+	}
+	if v := c.FtSugdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGDEL %v", v)
+	}
+	if v := s.FtSugget().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGGET %v", v)
+	}
+	if v := c.FtSugget().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGGET %v", v)
+	}
+	if v := s.FtSuglen().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGLEN %v", v)
+	}
+	if v := c.FtSuglen().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SUGLEN %v", v)
+	}
+	if v := s.FtSyndump().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SYNDUMP %v", v)
+	}
+	if v := c.FtSyndump().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SYNDUMP %v", v)
+	}
+	if v := s.FtSynupdate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SYNUPDATE %v", v)
+	}
+	if v := c.FtSynupdate().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.SYNUPDATE %v", v)
+	}
+	if v := s.FtTagvals().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.TAGVALS %v", v)
+	}
+	if v := c.FtTagvals().cf; v != 0 {
+		t.Fatalf("unexpected flags of FT.TAGVALS %v", v)
+	}
+	if v := s.Geoadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEOADD %v", v)
+	}
+	if v := c.Geoadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEOADD %v", v)
+	}
+	if v := s.Geodist().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEODIST %v", v)
+	}
+	if v := c.Geodist().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEODIST %v", v)
+	}
+	if v := s.Geohash().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOHASH %v", v)
+	}
+	if v := c.Geohash().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOHASH %v", v)
+	}
+	if v := s.Geopos().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOPOS %v", v)
+	}
+	if v := c.Geopos().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOPOS %v", v)
+	}
+	if v := s.Georadius().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEORADIUS %v", v)
+	}
+	if v := c.Georadius().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEORADIUS %v", v)
+	}
+	if v := s.GeoradiusRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEORADIUS_RO %v", v)
+	}
+	if v := c.GeoradiusRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEORADIUS_RO %v", v)
+	}
+	if v := s.Georadiusbymember().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEORADIUSBYMEMBER %v", v)
+	}
+	if v := c.Georadiusbymember().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEORADIUSBYMEMBER %v", v)
+	}
+	if v := s.GeoradiusbymemberRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEORADIUSBYMEMBER_RO %v", v)
+	}
+	if v := c.GeoradiusbymemberRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEORADIUSBYMEMBER_RO %v", v)
+	}
+	if v := s.Geosearch().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOSEARCH %v", v)
+	}
+	if v := c.Geosearch().cf; v != readonly {
+		t.Fatalf("unexpected flags of GEOSEARCH %v", v)
+	}
+	if v := s.Geosearchstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEOSEARCHSTORE %v", v)
+	}
+	if v := c.Geosearchstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of GEOSEARCHSTORE %v", v)
+	}
+	if v := s.Get().cf; v != readonly {
+		t.Fatalf("unexpected flags of GET %v", v)
+	}
+	if v := c.Get().cf; v != readonly {
+		t.Fatalf("unexpected flags of GET %v", v)
+	}
+	if v := s.Getbit().cf; v != readonly {
+		t.Fatalf("unexpected flags of GETBIT %v", v)
+	}
+	if v := c.Getbit().cf; v != readonly {
+		t.Fatalf("unexpected flags of GETBIT %v", v)
+	}
+	if v := s.Getdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETDEL %v", v)
+	}
+	if v := c.Getdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETDEL %v", v)
+	}
+	if v := s.Getex().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETEX %v", v)
+	}
+	if v := c.Getex().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETEX %v", v)
+	}
+	if v := s.Getrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of GETRANGE %v", v)
+	}
+	if v := c.Getrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of GETRANGE %v", v)
+	}
+	if v := s.Getset().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETSET %v", v)
+	}
+	if v := c.Getset().cf; v != 0 {
+		t.Fatalf("unexpected flags of GETSET %v", v)
+	}
+	if v := s.GraphConfigGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.CONFIG GET %v", v)
+	}
+	if v := c.GraphConfigGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.CONFIG GET %v", v)
+	}
+	if v := s.GraphConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.CONFIG SET %v", v)
+	}
+	if v := c.GraphConfigSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.CONFIG SET %v", v)
+	}
+	if v := s.GraphDelete().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.DELETE %v", v)
+	}
+	if v := c.GraphDelete().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.DELETE %v", v)
+	}
+	if v := s.GraphExplain().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.EXPLAIN %v", v)
+	}
+	if v := c.GraphExplain().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.EXPLAIN %v", v)
+	}
+	if v := s.GraphList().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.LIST %v", v)
+	}
+	if v := c.GraphList().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.LIST %v", v)
+	}
+	if v := s.GraphProfile().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.PROFILE %v", v)
+	}
+	if v := c.GraphProfile().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.PROFILE %v", v)
+	}
+	if v := s.GraphQuery().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.QUERY %v", v)
+	}
+	if v := c.GraphQuery().cf; v != 0 {
+		t.Fatalf("unexpected flags of GRAPH.QUERY %v", v)
+	}
+	if v := s.GraphRoQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.RO_QUERY %v", v)
+	}
+	if v := c.GraphRoQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.RO_QUERY %v", v)
+	}
+	if v := s.GraphSlowlog().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.SLOWLOG %v", v)
+	}
+	if v := c.GraphSlowlog().cf; v != readonly {
+		t.Fatalf("unexpected flags of GRAPH.SLOWLOG %v", v)
+	}
+	if v := s.Hdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of HDEL %v", v)
+	}
+	if v := c.Hdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of HDEL %v", v)
+	}
+	if v := s.Hello().cf; v != 0 {
+		t.Fatalf("unexpected flags of HELLO %v", v)
+	}
+	if v := c.Hello().cf; v != 0 {
+		t.Fatalf("unexpected flags of HELLO %v", v)
+	}
+	if v := s.Hexists().cf; v != readonly {
+		t.Fatalf("unexpected flags of HEXISTS %v", v)
+	}
+	if v := c.Hexists().cf; v != readonly {
+		t.Fatalf("unexpected flags of HEXISTS %v", v)
+	}
+	if v := s.Hget().cf; v != readonly {
+		t.Fatalf("unexpected flags of HGET %v", v)
+	}
+	if v := c.Hget().cf; v != readonly {
+		t.Fatalf("unexpected flags of HGET %v", v)
+	}
+	if v := s.Hgetall().cf; v != readonly {
+		t.Fatalf("unexpected flags of HGETALL %v", v)
+	}
+	if v := c.Hgetall().cf; v != readonly {
+		t.Fatalf("unexpected flags of HGETALL %v", v)
+	}
+	if v := s.Hincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of HINCRBY %v", v)
+	}
+	if v := c.Hincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of HINCRBY %v", v)
+	}
+	if v := s.Hincrbyfloat().cf; v != 0 {
+		t.Fatalf("unexpected flags of HINCRBYFLOAT %v", v)
+	}
+	if v := c.Hincrbyfloat().cf; v != 0 {
+		t.Fatalf("unexpected flags of HINCRBYFLOAT %v", v)
+	}
+	if v := s.Hkeys().cf; v != readonly {
+		t.Fatalf("unexpected flags of HKEYS %v", v)
+	}
+	if v := c.Hkeys().cf; v != readonly {
+		t.Fatalf("unexpected flags of HKEYS %v", v)
+	}
+	if v := s.Hlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of HLEN %v", v)
+	}
+	if v := c.Hlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of HLEN %v", v)
+	}
+	if v := s.Hmget().cf; v != readonly {
+		t.Fatalf("unexpected flags of HMGET %v", v)
+	}
+	if v := c.Hmget().cf; v != readonly {
+		t.Fatalf("unexpected flags of HMGET %v", v)
+	}
+	if v := s.Hmset().cf; v != 0 {
+		t.Fatalf("unexpected flags of HMSET %v", v)
+	}
+	if v := c.Hmset().cf; v != 0 {
+		t.Fatalf("unexpected flags of HMSET %v", v)
+	}
+	if v := s.Hrandfield().cf; v != readonly {
+		t.Fatalf("unexpected flags of HRANDFIELD %v", v)
+	}
+	if v := c.Hrandfield().cf; v != readonly {
+		t.Fatalf("unexpected flags of HRANDFIELD %v", v)
+	}
+	if v := s.Hscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of HSCAN %v", v)
+	}
+	if v := c.Hscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of HSCAN %v", v)
+	}
+	if v := s.Hset().cf; v != 0 {
+		t.Fatalf("unexpected flags of HSET %v", v)
+	}
+	if v := c.Hset().cf; v != 0 {
+		t.Fatalf("unexpected flags of HSET %v", v)
+	}
+	if v := s.Hsetnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of HSETNX %v", v)
+	}
+	if v := c.Hsetnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of HSETNX %v", v)
+	}
+	if v := s.Hstrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of HSTRLEN %v", v)
+	}
+	if v := c.Hstrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of HSTRLEN %v", v)
+	}
+	if v := s.Hvals().cf; v != readonly {
+		t.Fatalf("unexpected flags of HVALS %v", v)
+	}
+	if v := c.Hvals().cf; v != readonly {
+		t.Fatalf("unexpected flags of HVALS %v", v)
+	}
+	if v := s.Incr().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCR %v", v)
+	}
+	if v := c.Incr().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCR %v", v)
+	}
+	if v := s.Incrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCRBY %v", v)
+	}
+	if v := c.Incrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCRBY %v", v)
+	}
+	if v := s.Incrbyfloat().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCRBYFLOAT %v", v)
+	}
+	if v := c.Incrbyfloat().cf; v != 0 {
+		t.Fatalf("unexpected flags of INCRBYFLOAT %v", v)
+	}
+	if v := s.Info().cf; v != 0 {
+		t.Fatalf("unexpected flags of INFO %v", v)
+	}
+	if v := c.Info().cf; v != 0 {
+		t.Fatalf("unexpected flags of INFO %v", v)
+	}
+	if v := s.JsonArrappend().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRAPPEND %v", v)
+	}
+	if v := c.JsonArrappend().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRAPPEND %v", v)
+	}
+	if v := s.JsonArrindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.ARRINDEX %v", v)
+	}
+	if v := c.JsonArrindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.ARRINDEX %v", v)
+	}
+	if v := s.JsonArrinsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRINSERT %v", v)
+	}
+	if v := c.JsonArrinsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRINSERT %v", v)
+	}
+	if v := s.JsonArrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.ARRLEN %v", v)
+	}
+	if v := c.JsonArrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.ARRLEN %v", v)
+	}
+	if v := s.JsonArrpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRPOP %v", v)
+	}
+	if v := c.JsonArrpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRPOP %v", v)
+	}
+	if v := s.JsonArrtrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRTRIM %v", v)
+	}
+	if v := c.JsonArrtrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.ARRTRIM %v", v)
+	}
+	if v := s.JsonClear().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.CLEAR %v", v)
+	}
+	if v := c.JsonClear().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.CLEAR %v", v)
+	}
+	if v := s.JsonDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.DEL %v", v)
+	}
+	if v := c.JsonDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.DEL %v", v)
+	}
+	if v := s.JsonGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.GET %v", v)
+	}
+	if v := c.JsonGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.GET %v", v)
+	}
+	if v := s.JsonMget().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.MGET %v", v)
+	}
+	if v := c.JsonMget().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.MGET %v", v)
+	}
+	if v := s.JsonNumincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.NUMINCRBY %v", v)
+	}
+	if v := c.JsonNumincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.NUMINCRBY %v", v)
+	}
+	if v := s.JsonObjkeys().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.OBJKEYS %v", v)
+	}
+	if v := c.JsonObjkeys().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.OBJKEYS %v", v)
+	}
+	if v := s.JsonObjlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.OBJLEN %v", v)
+	}
+	if v := c.JsonObjlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.OBJLEN %v", v)
+	}
+	if v := s.JsonResp().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.RESP %v", v)
+	}
+	if v := c.JsonResp().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.RESP %v", v)
+	}
+	if v := s.JsonSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.SET %v", v)
+	}
+	if v := c.JsonSet().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.SET %v", v)
+	}
+	if v := s.JsonStrappend().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.STRAPPEND %v", v)
+	}
+	if v := c.JsonStrappend().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.STRAPPEND %v", v)
+	}
+	if v := s.JsonStrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.STRLEN %v", v)
+	}
+	if v := c.JsonStrlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.STRLEN %v", v)
+	}
+	if v := s.JsonToggle().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.TOGGLE %v", v)
+	}
+	if v := c.JsonToggle().cf; v != 0 {
+		t.Fatalf("unexpected flags of JSON.TOGGLE %v", v)
+	}
+	if v := s.JsonType().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.TYPE %v", v)
+	}
+	if v := c.JsonType().cf; v != readonly {
+		t.Fatalf("unexpected flags of JSON.TYPE %v", v)
+	}
+	if v := s.Keys().cf; v != readonly {
+		t.Fatalf("unexpected flags of KEYS %v", v)
+	}
+	if v := c.Keys().cf; v != readonly {
+		t.Fatalf("unexpected flags of KEYS %v", v)
+	}
+	if v := s.Lastsave().cf; v != 0 {
+		t.Fatalf("unexpected flags of LASTSAVE %v", v)
+	}
+	if v := c.Lastsave().cf; v != 0 {
+		t.Fatalf("unexpected flags of LASTSAVE %v", v)
+	}
+	if v := s.LatencyDoctor().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY DOCTOR %v", v)
+	}
+	if v := c.LatencyDoctor().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY DOCTOR %v", v)
+	}
+	if v := s.LatencyGraph().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY GRAPH %v", v)
+	}
+	if v := c.LatencyGraph().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY GRAPH %v", v)
+	}
+	if v := s.LatencyHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY HELP %v", v)
+	}
+	if v := c.LatencyHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY HELP %v", v)
+	}
+	if v := s.LatencyHistory().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY HISTORY %v", v)
+	}
+	if v := c.LatencyHistory().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY HISTORY %v", v)
+	}
+	if v := s.LatencyLatest().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY LATEST %v", v)
+	}
+	if v := c.LatencyLatest().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY LATEST %v", v)
+	}
+	if v := s.LatencyReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY RESET %v", v)
+	}
+	if v := c.LatencyReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of LATENCY RESET %v", v)
+	}
+	if v := s.Lcs().cf; v != readonly {
+		t.Fatalf("unexpected flags of LCS %v", v)
+	}
+	if v := c.Lcs().cf; v != readonly {
+		t.Fatalf("unexpected flags of LCS %v", v)
+	}
+	if v := s.Lindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of LINDEX %v", v)
+	}
+	if v := c.Lindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of LINDEX %v", v)
+	}
+	if v := s.Linsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of LINSERT %v", v)
+	}
+	if v := c.Linsert().cf; v != 0 {
+		t.Fatalf("unexpected flags of LINSERT %v", v)
+	}
+	if v := s.Llen().cf; v != readonly {
+		t.Fatalf("unexpected flags of LLEN %v", v)
+	}
+	if v := c.Llen().cf; v != readonly {
+		t.Fatalf("unexpected flags of LLEN %v", v)
+	}
+	if v := s.Lmove().cf; v != 0 {
+		t.Fatalf("unexpected flags of LMOVE %v", v)
+	}
+	if v := c.Lmove().cf; v != 0 {
+		t.Fatalf("unexpected flags of LMOVE %v", v)
+	}
+	if v := s.Lmpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of LMPOP %v", v)
+	}
+	if v := c.Lmpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of LMPOP %v", v)
+	}
+	if v := s.Lolwut().cf; v != readonly {
+		t.Fatalf("unexpected flags of LOLWUT %v", v)
+	}
+	if v := c.Lolwut().cf; v != readonly {
+		t.Fatalf("unexpected flags of LOLWUT %v", v)
+	}
+	if v := s.Lpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPOP %v", v)
+	}
+	if v := c.Lpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPOP %v", v)
+	}
+	if v := s.Lpos().cf; v != readonly {
+		t.Fatalf("unexpected flags of LPOS %v", v)
+	}
+	if v := c.Lpos().cf; v != readonly {
+		t.Fatalf("unexpected flags of LPOS %v", v)
+	}
+	if v := s.Lpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPUSH %v", v)
+	}
+	if v := c.Lpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPUSH %v", v)
+	}
+	if v := s.Lpushx().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPUSHX %v", v)
+	}
+	if v := c.Lpushx().cf; v != 0 {
+		t.Fatalf("unexpected flags of LPUSHX %v", v)
+	}
+	if v := s.Lrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of LRANGE %v", v)
+	}
+	if v := c.Lrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of LRANGE %v", v)
+	}
+	if v := s.Lrem().cf; v != 0 {
+		t.Fatalf("unexpected flags of LREM %v", v)
+	}
+	if v := c.Lrem().cf; v != 0 {
+		t.Fatalf("unexpected flags of LREM %v", v)
+	}
+	if v := s.Lset().cf; v != 0 {
+		t.Fatalf("unexpected flags of LSET %v", v)
+	}
+	if v := c.Lset().cf; v != 0 {
+		t.Fatalf("unexpected flags of LSET %v", v)
+	}
+	if v := s.Ltrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of LTRIM %v", v)
+	}
+	if v := c.Ltrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of LTRIM %v", v)
+	}
+	if v := s.MemoryDoctor().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY DOCTOR %v", v)
+	}
+	if v := c.MemoryDoctor().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY DOCTOR %v", v)
+	}
+	if v := s.MemoryHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of MEMORY HELP %v", v)
+	}
+	if v := c.MemoryHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of MEMORY HELP %v", v)
+	}
+	if v := s.MemoryMallocStats().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY MALLOC-STATS %v", v)
+	}
+	if v := c.MemoryMallocStats().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY MALLOC-STATS %v", v)
+	}
+	if v := s.MemoryPurge().cf; v != 0 {
+		t.Fatalf("unexpected flags of MEMORY PURGE %v", v)
+	}
+	if v := c.MemoryPurge().cf; v != 0 {
+		t.Fatalf("unexpected flags of MEMORY PURGE %v", v)
+	}
+	if v := s.MemoryStats().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY STATS %v", v)
+	}
+	if v := c.MemoryStats().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY STATS %v", v)
+	}
+	if v := s.MemoryUsage().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY USAGE %v", v)
+	}
+	if v := c.MemoryUsage().cf; v != readonly {
+		t.Fatalf("unexpected flags of MEMORY USAGE %v", v)
+	}
+	if v := s.Mget().cf; v != readonly {
+		t.Fatalf("unexpected flags of MGET %v", v)
+	}
+	if v := c.Mget().cf; v != readonly {
+		t.Fatalf("unexpected flags of MGET %v", v)
+	}
+	if v := s.Migrate().cf; v != blockTag {
+		t.Fatalf("unexpected flags of MIGRATE %v", v)
+	}
+	if v := c.Migrate().cf; v != blockTag {
+		t.Fatalf("unexpected flags of MIGRATE %v", v)
+	}
+	if v := s.ModuleList().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE LIST %v", v)
+	}
+	if v := c.ModuleList().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE LIST %v", v)
+	}
+	if v := s.ModuleLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE LOAD %v", v)
+	}
+	if v := c.ModuleLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE LOAD %v", v)
+	}
+	if v := s.ModuleUnload().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE UNLOAD %v", v)
+	}
+	if v := c.ModuleUnload().cf; v != 0 {
+		t.Fatalf("unexpected flags of MODULE UNLOAD %v", v)
+	}
+	if v := s.Monitor().cf; v != 0 {
+		t.Fatalf("unexpected flags of MONITOR %v", v)
+	}
+	if v := c.Monitor().cf; v != 0 {
+		t.Fatalf("unexpected flags of MONITOR %v", v)
+	}
+	if v := s.Move().cf; v != 0 {
+		t.Fatalf("unexpected flags of MOVE %v", v)
+	}
+	if v := c.Move().cf; v != 0 {
+		t.Fatalf("unexpected flags of MOVE %v", v)
+	}
+	if v := s.Mset().cf; v != 0 {
+		t.Fatalf("unexpected flags of MSET %v", v)
+	}
+	if v := c.Mset().cf; v != 0 {
+		t.Fatalf("unexpected flags of MSET %v", v)
+	}
+	if v := s.Msetnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of MSETNX %v", v)
+	}
+	if v := c.Msetnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of MSETNX %v", v)
+	}
+	if v := s.Multi().cf; v != 0 {
+		t.Fatalf("unexpected flags of MULTI %v", v)
+	}
+	if v := c.Multi().cf; v != 0 {
+		t.Fatalf("unexpected flags of MULTI %v", v)
+	}
+	if v := s.ObjectEncoding().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT ENCODING %v", v)
+	}
+	if v := c.ObjectEncoding().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT ENCODING %v", v)
+	}
+	if v := s.ObjectFreq().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT FREQ %v", v)
+	}
+	if v := c.ObjectFreq().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT FREQ %v", v)
+	}
+	if v := s.ObjectHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT HELP %v", v)
+	}
+	if v := c.ObjectHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT HELP %v", v)
+	}
+	if v := s.ObjectIdletime().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT IDLETIME %v", v)
+	}
+	if v := c.ObjectIdletime().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT IDLETIME %v", v)
+	}
+	if v := s.ObjectRefcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT REFCOUNT %v", v)
+	}
+	if v := c.ObjectRefcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of OBJECT REFCOUNT %v", v)
+	}
+	if v := s.Persist().cf; v != 0 {
+		t.Fatalf("unexpected flags of PERSIST %v", v)
+	}
+	if v := c.Persist().cf; v != 0 {
+		t.Fatalf("unexpected flags of PERSIST %v", v)
+	}
+	if v := s.Pexpire().cf; v != 0 {
+		t.Fatalf("unexpected flags of PEXPIRE %v", v)
+	}
+	if v := c.Pexpire().cf; v != 0 {
+		t.Fatalf("unexpected flags of PEXPIRE %v", v)
+	}
+	if v := s.Pexpireat().cf; v != 0 {
+		t.Fatalf("unexpected flags of PEXPIREAT %v", v)
+	}
+	if v := c.Pexpireat().cf; v != 0 {
+		t.Fatalf("unexpected flags of PEXPIREAT %v", v)
+	}
+	if v := s.Pexpiretime().cf; v != readonly {
+		t.Fatalf("unexpected flags of PEXPIRETIME %v", v)
+	}
+	if v := c.Pexpiretime().cf; v != readonly {
+		t.Fatalf("unexpected flags of PEXPIRETIME %v", v)
+	}
+	if v := s.Pfadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of PFADD %v", v)
+	}
+	if v := c.Pfadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of PFADD %v", v)
+	}
+	if v := s.Pfcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of PFCOUNT %v", v)
+	}
+	if v := c.Pfcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of PFCOUNT %v", v)
+	}
+	if v := s.Pfmerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of PFMERGE %v", v)
+	}
+	if v := c.Pfmerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of PFMERGE %v", v)
+	}
+	if v := s.Ping().cf; v != 0 {
+		t.Fatalf("unexpected flags of PING %v", v)
+	}
+	if v := c.Ping().cf; v != 0 {
+		t.Fatalf("unexpected flags of PING %v", v)
+	}
+	if v := s.Psetex().cf; v != 0 {
+		t.Fatalf("unexpected flags of PSETEX %v", v)
+	}
+	if v := c.Psetex().cf; v != 0 {
+		t.Fatalf("unexpected flags of PSETEX %v", v)
+	}
+	if v := s.Psubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of PSUBSCRIBE %v", v)
+	}
+	if v := c.Psubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of PSUBSCRIBE %v", v)
+	}
+	if v := s.Psync().cf; v != 0 {
+		t.Fatalf("unexpected flags of PSYNC %v", v)
+	}
+	if v := c.Psync().cf; v != 0 {
+		t.Fatalf("unexpected flags of PSYNC %v", v)
+	}
+	if v := s.Pttl().cf; v != readonly {
+		t.Fatalf("unexpected flags of PTTL %v", v)
+	}
+	if v := c.Pttl().cf; v != readonly {
+		t.Fatalf("unexpected flags of PTTL %v", v)
+	}
+	if v := s.Publish().cf; v != 0 {
+		t.Fatalf("unexpected flags of PUBLISH %v", v)
+	}
+	if v := c.Publish().cf; v != 0 {
+		t.Fatalf("unexpected flags of PUBLISH %v", v)
+	}
+	if v := s.PubsubChannels().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB CHANNELS %v", v)
+	}
+	if v := c.PubsubChannels().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB CHANNELS %v", v)
+	}
+	if v := s.PubsubHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB HELP %v", v)
+	}
+	if v := c.PubsubHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB HELP %v", v)
+	}
+	if v := s.PubsubNumpat().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB NUMPAT %v", v)
+	}
+	if v := c.PubsubNumpat().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB NUMPAT %v", v)
+	}
+	if v := s.PubsubNumsub().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB NUMSUB %v", v)
+	}
+	if v := c.PubsubNumsub().cf; v != readonly {
+		t.Fatalf("unexpected flags of PUBSUB NUMSUB %v", v)
+	}
+	if v := s.Punsubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of PUNSUBSCRIBE %v", v)
+	}
+	if v := c.Punsubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of PUNSUBSCRIBE %v", v)
+	}
+	if v := s.Quit().cf; v != 0 {
+		t.Fatalf("unexpected flags of QUIT %v", v)
+	}
+	if v := c.Quit().cf; v != 0 {
+		t.Fatalf("unexpected flags of QUIT %v", v)
+	}
+	if v := s.Randomkey().cf; v != readonly {
+		t.Fatalf("unexpected flags of RANDOMKEY %v", v)
+	}
+	if v := c.Randomkey().cf; v != readonly {
+		t.Fatalf("unexpected flags of RANDOMKEY %v", v)
+	}
+	if v := s.Readonly().cf; v != 0 {
+		t.Fatalf("unexpected flags of READONLY %v", v)
+	}
+	if v := c.Readonly().cf; v != 0 {
+		t.Fatalf("unexpected flags of READONLY %v", v)
+	}
+	if v := s.Readwrite().cf; v != 0 {
+		t.Fatalf("unexpected flags of READWRITE %v", v)
+	}
+	if v := c.Readwrite().cf; v != 0 {
+		t.Fatalf("unexpected flags of READWRITE %v", v)
+	}
+	if v := s.Rename().cf; v != 0 {
+		t.Fatalf("unexpected flags of RENAME %v", v)
+	}
+	if v := c.Rename().cf; v != 0 {
+		t.Fatalf("unexpected flags of RENAME %v", v)
+	}
+	if v := s.Renamenx().cf; v != 0 {
+		t.Fatalf("unexpected flags of RENAMENX %v", v)
+	}
+	if v := c.Renamenx().cf; v != 0 {
+		t.Fatalf("unexpected flags of RENAMENX %v", v)
+	}
+	if v := s.Replicaof().cf; v != 0 {
+		t.Fatalf("unexpected flags of REPLICAOF %v", v)
+	}
+	if v := c.Replicaof().cf; v != 0 {
+		t.Fatalf("unexpected flags of REPLICAOF %v", v)
+	}
+	if v := s.Reset().cf; v != 0 {
+		t.Fatalf("unexpected flags of RESET %v", v)
+	}
+	if v := c.Reset().cf; v != 0 {
+		t.Fatalf("unexpected flags of RESET %v", v)
+	}
+	if v := s.Restore().cf; v != 0 {
+		t.Fatalf("unexpected flags of RESTORE %v", v)
+	}
+	if v := c.Restore().cf; v != 0 {
+		t.Fatalf("unexpected flags of RESTORE %v", v)
+	}
+	if v := s.Role().cf; v != 0 {
+		t.Fatalf("unexpected flags of ROLE %v", v)
+	}
+	if v := c.Role().cf; v != 0 {
+		t.Fatalf("unexpected flags of ROLE %v", v)
+	}
+	if v := s.Rpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPOP %v", v)
+	}
+	if v := c.Rpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPOP %v", v)
+	}
+	if v := s.Rpoplpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPOPLPUSH %v", v)
+	}
+	if v := c.Rpoplpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPOPLPUSH %v", v)
+	}
+	if v := s.Rpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPUSH %v", v)
+	}
+	if v := c.Rpush().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPUSH %v", v)
+	}
+	if v := s.Rpushx().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPUSHX %v", v)
+	}
+	if v := c.Rpushx().cf; v != 0 {
+		t.Fatalf("unexpected flags of RPUSHX %v", v)
+	}
+	if v := s.Sadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of SADD %v", v)
+	}
+	if v := c.Sadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of SADD %v", v)
+	}
+	if v := s.Save().cf; v != 0 {
+		t.Fatalf("unexpected flags of SAVE %v", v)
+	}
+	if v := c.Save().cf; v != 0 {
+		t.Fatalf("unexpected flags of SAVE %v", v)
+	}
+	if v := s.Scan().cf; v != readonly {
+		t.Fatalf("unexpected flags of SCAN %v", v)
+	}
+	if v := c.Scan().cf; v != readonly {
+		t.Fatalf("unexpected flags of SCAN %v", v)
+	}
+	if v := s.Scard().cf; v != readonly {
+		t.Fatalf("unexpected flags of SCARD %v", v)
+	}
+	if v := c.Scard().cf; v != readonly {
+		t.Fatalf("unexpected flags of SCARD %v", v)
+	}
+	if v := s.ScriptDebug().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT DEBUG %v", v)
+	}
+	if v := c.ScriptDebug().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT DEBUG %v", v)
+	}
+	if v := s.ScriptExists().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT EXISTS %v", v)
+	}
+	if v := c.ScriptExists().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT EXISTS %v", v)
+	}
+	if v := s.ScriptFlush().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT FLUSH %v", v)
+	}
+	if v := c.ScriptFlush().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT FLUSH %v", v)
+	}
+	if v := s.ScriptKill().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT KILL %v", v)
+	}
+	if v := c.ScriptKill().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT KILL %v", v)
+	}
+	if v := s.ScriptLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT LOAD %v", v)
+	}
+	if v := c.ScriptLoad().cf; v != 0 {
+		t.Fatalf("unexpected flags of SCRIPT LOAD %v", v)
+	}
+	if v := s.Sdiff().cf; v != readonly {
+		t.Fatalf("unexpected flags of SDIFF %v", v)
+	}
+	if v := c.Sdiff().cf; v != readonly {
+		t.Fatalf("unexpected flags of SDIFF %v", v)
+	}
+	if v := s.Sdiffstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SDIFFSTORE %v", v)
+	}
+	if v := c.Sdiffstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SDIFFSTORE %v", v)
+	}
+	if v := s.Select().cf; v != 0 {
+		t.Fatalf("unexpected flags of SELECT %v", v)
+	}
+	if v := c.Select().cf; v != 0 {
+		t.Fatalf("unexpected flags of SELECT %v", v)
+	}
+	if v := s.Set().cf; v != 0 {
+		t.Fatalf("unexpected flags of SET %v", v)
+	}
+	if v := c.Set().cf; v != 0 {
+		t.Fatalf("unexpected flags of SET %v", v)
+	}
+	if v := s.Setbit().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETBIT %v", v)
+	}
+	if v := c.Setbit().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETBIT %v", v)
+	}
+	if v := s.Setex().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETEX %v", v)
+	}
+	if v := c.Setex().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETEX %v", v)
+	}
+	if v := s.Setnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETNX %v", v)
+	}
+	if v := c.Setnx().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETNX %v", v)
+	}
+	if v := s.Setrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETRANGE %v", v)
+	}
+	if v := c.Setrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of SETRANGE %v", v)
+	}
+	if v := s.Shutdown().cf; v != 0 {
+		t.Fatalf("unexpected flags of SHUTDOWN %v", v)
+	}
+	if v := c.Shutdown().cf; v != 0 {
+		t.Fatalf("unexpected flags of SHUTDOWN %v", v)
+	}
+	if v := s.Sinter().cf; v != readonly {
+		t.Fatalf("unexpected flags of SINTER %v", v)
+	}
+	if v := c.Sinter().cf; v != readonly {
+		t.Fatalf("unexpected flags of SINTER %v", v)
+	}
+	if v := s.Sintercard().cf; v != readonly {
+		t.Fatalf("unexpected flags of SINTERCARD %v", v)
+	}
+	if v := c.Sintercard().cf; v != readonly {
+		t.Fatalf("unexpected flags of SINTERCARD %v", v)
+	}
+	if v := s.Sinterstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SINTERSTORE %v", v)
+	}
+	if v := c.Sinterstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SINTERSTORE %v", v)
+	}
+	if v := s.Sismember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SISMEMBER %v", v)
+	}
+	if v := c.Sismember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SISMEMBER %v", v)
+	}
+	if v := s.Slaveof().cf; v != 0 {
+		t.Fatalf("unexpected flags of SLAVEOF %v", v)
+	}
+	if v := c.Slaveof().cf; v != 0 {
+		t.Fatalf("unexpected flags of SLAVEOF %v", v)
+	}
+	if v := s.SlowlogGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG GET %v", v)
+	}
+	if v := c.SlowlogGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG GET %v", v)
+	}
+	if v := s.SlowlogHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG HELP %v", v)
+	}
+	if v := c.SlowlogHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG HELP %v", v)
+	}
+	if v := s.SlowlogLen().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG LEN %v", v)
+	}
+	if v := c.SlowlogLen().cf; v != readonly {
+		t.Fatalf("unexpected flags of SLOWLOG LEN %v", v)
+	}
+	if v := s.SlowlogReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of SLOWLOG RESET %v", v)
+	}
+	if v := c.SlowlogReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of SLOWLOG RESET %v", v)
+	}
+	if v := s.Smembers().cf; v != readonly {
+		t.Fatalf("unexpected flags of SMEMBERS %v", v)
+	}
+	if v := c.Smembers().cf; v != readonly {
+		t.Fatalf("unexpected flags of SMEMBERS %v", v)
+	}
+	if v := s.Smismember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SMISMEMBER %v", v)
+	}
+	if v := c.Smismember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SMISMEMBER %v", v)
+	}
+	if v := s.Smove().cf; v != 0 {
+		t.Fatalf("unexpected flags of SMOVE %v", v)
+	}
+	if v := c.Smove().cf; v != 0 {
+		t.Fatalf("unexpected flags of SMOVE %v", v)
+	}
+	if v := s.Sort().cf; v != 0 {
+		t.Fatalf("unexpected flags of SORT %v", v)
+	}
+	if v := c.Sort().cf; v != 0 {
+		t.Fatalf("unexpected flags of SORT %v", v)
+	}
+	if v := s.SortRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of SORT_RO %v", v)
+	}
+	if v := c.SortRo().cf; v != readonly {
+		t.Fatalf("unexpected flags of SORT_RO %v", v)
+	}
+	if v := s.Spop().cf; v != 0 {
+		t.Fatalf("unexpected flags of SPOP %v", v)
+	}
+	if v := c.Spop().cf; v != 0 {
+		t.Fatalf("unexpected flags of SPOP %v", v)
+	}
+	if v := s.Srandmember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SRANDMEMBER %v", v)
+	}
+	if v := c.Srandmember().cf; v != readonly {
+		t.Fatalf("unexpected flags of SRANDMEMBER %v", v)
+	}
+	if v := s.Srem().cf; v != 0 {
+		t.Fatalf("unexpected flags of SREM %v", v)
+	}
+	if v := c.Srem().cf; v != 0 {
+		t.Fatalf("unexpected flags of SREM %v", v)
+	}
+	if v := s.Sscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of SSCAN %v", v)
+	}
+	if v := c.Sscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of SSCAN %v", v)
+	}
+	if v := s.Strlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of STRLEN %v", v)
+	}
+	if v := c.Strlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of STRLEN %v", v)
+	}
+	if v := s.Subscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of SUBSCRIBE %v", v)
+	}
+	if v := c.Subscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of SUBSCRIBE %v", v)
+	}
+	if v := s.Sunion().cf; v != readonly {
+		t.Fatalf("unexpected flags of SUNION %v", v)
+	}
+	if v := c.Sunion().cf; v != readonly {
+		t.Fatalf("unexpected flags of SUNION %v", v)
+	}
+	if v := s.Sunionstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SUNIONSTORE %v", v)
+	}
+	if v := c.Sunionstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of SUNIONSTORE %v", v)
+	}
+	if v := s.Swapdb().cf; v != 0 {
+		t.Fatalf("unexpected flags of SWAPDB %v", v)
+	}
+	if v := c.Swapdb().cf; v != 0 {
+		t.Fatalf("unexpected flags of SWAPDB %v", v)
+	}
+	if v := s.Sync().cf; v != 0 {
+		t.Fatalf("unexpected flags of SYNC %v", v)
+	}
+	if v := c.Sync().cf; v != 0 {
+		t.Fatalf("unexpected flags of SYNC %v", v)
+	}
+	if v := s.TdigestAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.ADD %v", v)
+	}
+	if v := c.TdigestAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.ADD %v", v)
+	}
+	if v := s.TdigestCdf().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.CDF %v", v)
+	}
+	if v := c.TdigestCdf().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.CDF %v", v)
+	}
+	if v := s.TdigestCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.CREATE %v", v)
+	}
+	if v := c.TdigestCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.CREATE %v", v)
+	}
+	if v := s.TdigestInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.INFO %v", v)
+	}
+	if v := c.TdigestInfo().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.INFO %v", v)
+	}
+	if v := s.TdigestMax().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MAX %v", v)
+	}
+	if v := c.TdigestMax().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MAX %v", v)
+	}
+	if v := s.TdigestMerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MERGE %v", v)
+	}
+	if v := c.TdigestMerge().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MERGE %v", v)
+	}
+	if v := s.TdigestMin().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MIN %v", v)
+	}
+	if v := c.TdigestMin().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.MIN %v", v)
+	}
+	if v := s.TdigestQuantile().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.QUANTILE %v", v)
+	}
+	if v := c.TdigestQuantile().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.QUANTILE %v", v)
+	}
+	if v := s.TdigestReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.RESET %v", v)
+	}
+	if v := c.TdigestReset().cf; v != 0 {
+		t.Fatalf("unexpected flags of TDIGEST.RESET %v", v)
+	}
+	if v := s.Time().cf; v != 0 {
+		t.Fatalf("unexpected flags of TIME %v", v)
+	}
+	if v := c.Time().cf; v != 0 {
+		t.Fatalf("unexpected flags of TIME %v", v)
+	}
+	if v := s.TopkAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.ADD %v", v)
+	}
+	if v := c.TopkAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.ADD %v", v)
+	}
+	if v := s.TopkIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.INCRBY %v", v)
+	}
+	if v := c.TopkIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.INCRBY %v", v)
+	}
+	if v := s.TopkInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.INFO %v", v)
+	}
+	if v := c.TopkInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.INFO %v", v)
+	}
+	if v := s.TopkList().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.LIST %v", v)
+	}
+	if v := c.TopkList().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.LIST %v", v)
+	}
+	if v := s.TopkQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.QUERY %v", v)
+	}
+	if v := c.TopkQuery().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOPK.QUERY %v", v)
+	}
+	if v := s.TopkReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.RESERVE %v", v)
+	}
+	if v := c.TopkReserve().cf; v != 0 {
+		t.Fatalf("unexpected flags of TOPK.RESERVE %v", v)
+	}
+	if v := s.Touch().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOUCH %v", v)
+	}
+	if v := c.Touch().cf; v != readonly {
+		t.Fatalf("unexpected flags of TOUCH %v", v)
+	}
+	if v := s.TsAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.ADD %v", v)
+	}
+	if v := c.TsAdd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.ADD %v", v)
+	}
+	if v := s.TsAlter().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.ALTER %v", v)
+	}
+	if v := c.TsAlter().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.ALTER %v", v)
+	}
+	if v := s.TsCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.CREATE %v", v)
+	}
+	if v := c.TsCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.CREATE %v", v)
+	}
+	if v := s.TsCreaterule().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.CREATERULE %v", v)
+	}
+	if v := c.TsCreaterule().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.CREATERULE %v", v)
+	}
+	if v := s.TsDecrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DECRBY %v", v)
+	}
+	if v := c.TsDecrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DECRBY %v", v)
+	}
+	if v := s.TsDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DEL %v", v)
+	}
+	if v := c.TsDel().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DEL %v", v)
+	}
+	if v := s.TsDeleterule().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DELETERULE %v", v)
+	}
+	if v := c.TsDeleterule().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.DELETERULE %v", v)
+	}
+	if v := s.TsGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.GET %v", v)
+	}
+	if v := c.TsGet().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.GET %v", v)
+	}
+	if v := s.TsIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.INCRBY %v", v)
+	}
+	if v := c.TsIncrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.INCRBY %v", v)
+	}
+	if v := s.TsInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.INFO %v", v)
+	}
+	if v := c.TsInfo().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.INFO %v", v)
+	}
+	if v := s.TsMadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MADD %v", v)
+	}
+	if v := c.TsMadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MADD %v", v)
+	}
+	if v := s.TsMget().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MGET %v", v)
+	}
+	if v := c.TsMget().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MGET %v", v)
+	}
+	if v := s.TsMrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MRANGE %v", v)
+	}
+	if v := c.TsMrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MRANGE %v", v)
+	}
+	if v := s.TsMrevrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MREVRANGE %v", v)
+	}
+	if v := c.TsMrevrange().cf; v != 0 {
+		t.Fatalf("unexpected flags of TS.MREVRANGE %v", v)
+	}
+	if v := s.TsQueryindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.QUERYINDEX %v", v)
+	}
+	if v := c.TsQueryindex().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.QUERYINDEX %v", v)
+	}
+	if v := s.TsRange().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.RANGE %v", v)
+	}
+	if v := c.TsRange().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.RANGE %v", v)
+	}
+	if v := s.TsRevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.REVRANGE %v", v)
+	}
+	if v := c.TsRevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of TS.REVRANGE %v", v)
+	}
+	if v := s.Ttl().cf; v != readonly {
+		t.Fatalf("unexpected flags of TTL %v", v)
+	}
+	if v := c.Ttl().cf; v != readonly {
+		t.Fatalf("unexpected flags of TTL %v", v)
+	}
+	if v := s.Type().cf; v != readonly {
+		t.Fatalf("unexpected flags of TYPE %v", v)
+	}
+	if v := c.Type().cf; v != readonly {
+		t.Fatalf("unexpected flags of TYPE %v", v)
+	}
+	if v := s.Unlink().cf; v != 0 {
+		t.Fatalf("unexpected flags of UNLINK %v", v)
+	}
+	if v := c.Unlink().cf; v != 0 {
+		t.Fatalf("unexpected flags of UNLINK %v", v)
+	}
+	if v := s.Unsubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of UNSUBSCRIBE %v", v)
+	}
+	if v := c.Unsubscribe().cf; v != noRetTag {
+		t.Fatalf("unexpected flags of UNSUBSCRIBE %v", v)
+	}
+	if v := s.Unwatch().cf; v != 0 {
+		t.Fatalf("unexpected flags of UNWATCH %v", v)
+	}
+	if v := c.Unwatch().cf; v != 0 {
+		t.Fatalf("unexpected flags of UNWATCH %v", v)
+	}
+	if v := s.Wait().cf; v != blockTag {
+		t.Fatalf("unexpected flags of WAIT %v", v)
+	}
+	if v := c.Wait().cf; v != blockTag {
+		t.Fatalf("unexpected flags of WAIT %v", v)
+	}
+	if v := s.Watch().cf; v != 0 {
+		t.Fatalf("unexpected flags of WATCH %v", v)
+	}
+	if v := c.Watch().cf; v != 0 {
+		t.Fatalf("unexpected flags of WATCH %v", v)
+	}
+	if v := s.Xack().cf; v != 0 {
+		t.Fatalf("unexpected flags of XACK %v", v)
+	}
+	if v := c.Xack().cf; v != 0 {
+		t.Fatalf("unexpected flags of XACK %v", v)
+	}
+	if v := s.Xadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of XADD %v", v)
+	}
+	if v := c.Xadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of XADD %v", v)
+	}
+	if v := s.Xautoclaim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XAUTOCLAIM %v", v)
+	}
+	if v := c.Xautoclaim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XAUTOCLAIM %v", v)
+	}
+	if v := s.Xclaim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XCLAIM %v", v)
+	}
+	if v := c.Xclaim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XCLAIM %v", v)
+	}
+	if v := s.Xdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of XDEL %v", v)
+	}
+	if v := c.Xdel().cf; v != 0 {
+		t.Fatalf("unexpected flags of XDEL %v", v)
+	}
+	if v := s.XgroupCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP CREATE %v", v)
+	}
+	if v := c.XgroupCreate().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP CREATE %v", v)
+	}
+	if v := s.XgroupCreateconsumer().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP CREATECONSUMER %v", v)
+	}
+	if v := c.XgroupCreateconsumer().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP CREATECONSUMER %v", v)
+	}
+	if v := s.XgroupDelconsumer().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP DELCONSUMER %v", v)
+	}
+	if v := c.XgroupDelconsumer().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP DELCONSUMER %v", v)
+	}
+	if v := s.XgroupDestroy().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP DESTROY %v", v)
+	}
+	if v := c.XgroupDestroy().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP DESTROY %v", v)
+	}
+	if v := s.XgroupHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP HELP %v", v)
+	}
+	if v := c.XgroupHelp().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP HELP %v", v)
+	}
+	if v := s.XgroupSetid().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP SETID %v", v)
+	}
+	if v := c.XgroupSetid().cf; v != 0 {
+		t.Fatalf("unexpected flags of XGROUP SETID %v", v)
+	}
+	if v := s.XinfoConsumers().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO CONSUMERS %v", v)
+	}
+	if v := c.XinfoConsumers().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO CONSUMERS %v", v)
+	}
+	if v := s.XinfoGroups().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO GROUPS %v", v)
+	}
+	if v := c.XinfoGroups().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO GROUPS %v", v)
+	}
+	if v := s.XinfoHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO HELP %v", v)
+	}
+	if v := c.XinfoHelp().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO HELP %v", v)
+	}
+	if v := s.XinfoStream().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO STREAM %v", v)
+	}
+	if v := c.XinfoStream().cf; v != readonly {
+		t.Fatalf("unexpected flags of XINFO STREAM %v", v)
+	}
+	if v := s.Xlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of XLEN %v", v)
+	}
+	if v := c.Xlen().cf; v != readonly {
+		t.Fatalf("unexpected flags of XLEN %v", v)
+	}
+	if v := s.Xpending().cf; v != readonly {
+		t.Fatalf("unexpected flags of XPENDING %v", v)
+	}
+	if v := c.Xpending().cf; v != readonly {
+		t.Fatalf("unexpected flags of XPENDING %v", v)
+	}
+	if v := s.Xrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of XRANGE %v", v)
+	}
+	if v := c.Xrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of XRANGE %v", v)
+	}
+	if v := s.Xread().cf; v != readonly {
+		t.Fatalf("unexpected flags of XREAD %v", v)
+	}
+	if v := c.Xread().cf; v != readonly {
+		t.Fatalf("unexpected flags of XREAD %v", v)
+	}
+	if v := s.Xreadgroup().cf; v != 0 {
+		t.Fatalf("unexpected flags of XREADGROUP %v", v)
+	}
+	if v := c.Xreadgroup().cf; v != 0 {
+		t.Fatalf("unexpected flags of XREADGROUP %v", v)
+	}
+	if v := s.Xrevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of XREVRANGE %v", v)
+	}
+	if v := c.Xrevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of XREVRANGE %v", v)
+	}
+	if v := s.Xtrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XTRIM %v", v)
+	}
+	if v := c.Xtrim().cf; v != 0 {
+		t.Fatalf("unexpected flags of XTRIM %v", v)
+	}
+	if v := s.Zadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZADD %v", v)
+	}
+	if v := c.Zadd().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZADD %v", v)
+	}
+	if v := s.Zcard().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZCARD %v", v)
+	}
+	if v := c.Zcard().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZCARD %v", v)
+	}
+	if v := s.Zcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZCOUNT %v", v)
+	}
+	if v := c.Zcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZCOUNT %v", v)
+	}
+	if v := s.Zdiff().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZDIFF %v", v)
+	}
+	if v := c.Zdiff().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZDIFF %v", v)
+	}
+	if v := s.Zdiffstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZDIFFSTORE %v", v)
+	}
+	if v := c.Zdiffstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZDIFFSTORE %v", v)
+	}
+	if v := s.Zincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZINCRBY %v", v)
+	}
+	if v := c.Zincrby().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZINCRBY %v", v)
+	}
+	if v := s.Zinter().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZINTER %v", v)
+	}
+	if v := c.Zinter().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZINTER %v", v)
+	}
+	if v := s.Zintercard().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZINTERCARD %v", v)
+	}
+	if v := c.Zintercard().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZINTERCARD %v", v)
+	}
+	if v := s.Zinterstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZINTERSTORE %v", v)
+	}
+	if v := c.Zinterstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZINTERSTORE %v", v)
+	}
+	if v := s.Zlexcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZLEXCOUNT %v", v)
+	}
+	if v := c.Zlexcount().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZLEXCOUNT %v", v)
+	}
+	if v := s.Zmpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZMPOP %v", v)
+	}
+	if v := c.Zmpop().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZMPOP %v", v)
+	}
+	if v := s.Zmscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZMSCORE %v", v)
+	}
+	if v := c.Zmscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZMSCORE %v", v)
+	}
+	if v := s.Zpopmax().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZPOPMAX %v", v)
+	}
+	if v := c.Zpopmax().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZPOPMAX %v", v)
+	}
+	if v := s.Zpopmin().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZPOPMIN %v", v)
+	}
+	if v := c.Zpopmin().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZPOPMIN %v", v)
+	}
+	if v := s.Zrandmember().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANDMEMBER %v", v)
+	}
+	if v := c.Zrandmember().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANDMEMBER %v", v)
+	}
+	if v := s.Zrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGE %v", v)
+	}
+	if v := c.Zrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGE %v", v)
+	}
+	if v := s.Zrangebylex().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGEBYLEX %v", v)
+	}
+	if v := c.Zrangebylex().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGEBYLEX %v", v)
+	}
+	if v := s.Zrangebyscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGEBYSCORE %v", v)
+	}
+	if v := c.Zrangebyscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANGEBYSCORE %v", v)
+	}
+	if v := s.Zrangestore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZRANGESTORE %v", v)
+	}
+	if v := c.Zrangestore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZRANGESTORE %v", v)
+	}
+	if v := s.Zrank().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANK %v", v)
+	}
+	if v := c.Zrank().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZRANK %v", v)
+	}
+	if v := s.Zrem().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREM %v", v)
+	}
+	if v := c.Zrem().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREM %v", v)
+	}
+	if v := s.Zremrangebylex().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYLEX %v", v)
+	}
+	if v := c.Zremrangebylex().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYLEX %v", v)
+	}
+	if v := s.Zremrangebyrank().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYRANK %v", v)
+	}
+	if v := c.Zremrangebyrank().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYRANK %v", v)
+	}
+	if v := s.Zremrangebyscore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYSCORE %v", v)
+	}
+	if v := c.Zremrangebyscore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZREMRANGEBYSCORE %v", v)
+	}
+	if v := s.Zrevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGE %v", v)
+	}
+	if v := c.Zrevrange().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGE %v", v)
+	}
+	if v := s.Zrevrangebylex().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGEBYLEX %v", v)
+	}
+	if v := c.Zrevrangebylex().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGEBYLEX %v", v)
+	}
+	if v := s.Zrevrangebyscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGEBYSCORE %v", v)
+	}
+	if v := c.Zrevrangebyscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANGEBYSCORE %v", v)
+	}
+	if v := s.Zrevrank().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANK %v", v)
+	}
+	if v := c.Zrevrank().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZREVRANK %v", v)
+	}
+	if v := s.Zscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZSCAN %v", v)
+	}
+	if v := c.Zscan().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZSCAN %v", v)
+	}
+	if v := s.Zscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZSCORE %v", v)
+	}
+	if v := c.Zscore().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZSCORE %v", v)
+	}
+	if v := s.Zunion().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZUNION %v", v)
+	}
+	if v := c.Zunion().cf; v != readonly {
+		t.Fatalf("unexpected flags of ZUNION %v", v)
+	}
+	if v := s.Zunionstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZUNIONSTORE %v", v)
+	}
+	if v := c.Zunionstore().cf; v != 0 {
+		t.Fatalf("unexpected flags of ZUNIONSTORE %v", v)
+	}
+}
+
+func TestBytesBuilders(t *testing.T) {
+	if a, b := (AppendKey{ks: InitSlot}).ValueBytes([]byte("1")), (AppendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected AppendKey.ValueBytes %v", a)
+	}
+	if a, b := (SAppendKey{ks: InitSlot}).ValueBytes([]byte("1")), (SAppendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SAppendKey.ValueBytes %v", a)
+	}
+	if a, b := (BfAddKey{ks: InitSlot}).ItemBytes([]byte("1")), (BfAddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfAddKey.ItemBytes %v", a)
+	}
+	if a, b := (SBfAddKey{ks: InitSlot}).ItemBytes([]byte("1")), (SBfAddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfAddKey.ItemBytes %v", a)
+	}
+	if a, b := (BfExistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (BfExistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfExistsKey.ItemBytes %v", a)
+	}
+	if a, b := (SBfExistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (SBfExistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfExistsKey.ItemBytes %v", a)
+	}
+	if a, b := (BfInsertItem{ks: InitSlot}).ItemBytes([]byte("1")), (BfInsertItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfInsertItem.ItemBytes %v", a)
+	}
+	if a, b := (SBfInsertItem{ks: InitSlot}).ItemBytes([]byte("1")), (SBfInsertItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfInsertItem.ItemBytes %v", a)
+	}
+	if a, b := (BfInsertItems{ks: InitSlot}).ItemBytes([]byte("1")), (BfInsertItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfInsertItems.ItemBytes %v", a)
+	}
+	if a, b := (SBfInsertItems{ks: InitSlot}).ItemBytes([]byte("1")), (SBfInsertItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfInsertItems.ItemBytes %v", a)
+	}
+	if a, b := (BfMaddItem{ks: InitSlot}).ItemBytes([]byte("1")), (BfMaddItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfMaddItem.ItemBytes %v", a)
+	}
+	if a, b := (SBfMaddItem{ks: InitSlot}).ItemBytes([]byte("1")), (SBfMaddItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfMaddItem.ItemBytes %v", a)
+	}
+	if a, b := (BfMaddKey{ks: InitSlot}).ItemBytes([]byte("1")), (BfMaddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfMaddKey.ItemBytes %v", a)
+	}
+	if a, b := (SBfMaddKey{ks: InitSlot}).ItemBytes([]byte("1")), (SBfMaddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfMaddKey.ItemBytes %v", a)
+	}
+	if a, b := (BfMexistsItem{ks: InitSlot}).ItemBytes([]byte("1")), (BfMexistsItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfMexistsItem.ItemBytes %v", a)
+	}
+	if a, b := (SBfMexistsItem{ks: InitSlot}).ItemBytes([]byte("1")), (SBfMexistsItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfMexistsItem.ItemBytes %v", a)
+	}
+	if a, b := (BfMexistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (BfMexistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected BfMexistsKey.ItemBytes %v", a)
+	}
+	if a, b := (SBfMexistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (SBfMexistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SBfMexistsKey.ItemBytes %v", a)
+	}
+	if a, b := (CfAddKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfAddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfAddKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfAddKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfAddKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfAddKey.ItemBytes %v", a)
+	}
+	if a, b := (CfAddnxItem{ks: InitSlot}).ItemBytes([]byte("1")), (CfAddnxItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfAddnxItem.ItemBytes %v", a)
+	}
+	if a, b := (SCfAddnxItem{ks: InitSlot}).ItemBytes([]byte("1")), (SCfAddnxItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfAddnxItem.ItemBytes %v", a)
+	}
+	if a, b := (CfAddnxKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfAddnxKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfAddnxKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfAddnxKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfAddnxKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfAddnxKey.ItemBytes %v", a)
+	}
+	if a, b := (CfCountKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfCountKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfCountKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfCountKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfCountKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfCountKey.ItemBytes %v", a)
+	}
+	if a, b := (CfDelKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfDelKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfDelKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfDelKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfDelKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfDelKey.ItemBytes %v", a)
+	}
+	if a, b := (CfExistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfExistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfExistsKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfExistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfExistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfExistsKey.ItemBytes %v", a)
+	}
+	if a, b := (CfInsertItem{ks: InitSlot}).ItemBytes([]byte("1")), (CfInsertItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfInsertItem.ItemBytes %v", a)
+	}
+	if a, b := (SCfInsertItem{ks: InitSlot}).ItemBytes([]byte("1")), (SCfInsertItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfInsertItem.ItemBytes %v", a)
+	}
+	if a, b := (CfInsertItems{ks: InitSlot}).ItemBytes([]byte("1")), (CfInsertItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfInsertItems.ItemBytes %v", a)
+	}
+	if a, b := (SCfInsertItems{ks: InitSlot}).ItemBytes([]byte("1")), (SCfInsertItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfInsertItems.ItemBytes %v", a)
+	}
+	if a, b := (CfInsertnxItem{ks: InitSlot}).ItemBytes([]byte("1")), (CfInsertnxItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfInsertnxItem.ItemBytes %v", a)
+	}
+	if a, b := (SCfInsertnxItem{ks: InitSlot}).ItemBytes([]byte("1")), (SCfInsertnxItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfInsertnxItem.ItemBytes %v", a)
+	}
+	if a, b := (CfInsertnxItems{ks: InitSlot}).ItemBytes([]byte("1")), (CfInsertnxItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfInsertnxItems.ItemBytes %v", a)
+	}
+	if a, b := (SCfInsertnxItems{ks: InitSlot}).ItemBytes([]byte("1")), (SCfInsertnxItems{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfInsertnxItems.ItemBytes %v", a)
+	}
+	if a, b := (CfMexistsItem{ks: InitSlot}).ItemBytes([]byte("1")), (CfMexistsItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfMexistsItem.ItemBytes %v", a)
+	}
+	if a, b := (SCfMexistsItem{ks: InitSlot}).ItemBytes([]byte("1")), (SCfMexistsItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfMexistsItem.ItemBytes %v", a)
+	}
+	if a, b := (CfMexistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (CfMexistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CfMexistsKey.ItemBytes %v", a)
+	}
+	if a, b := (SCfMexistsKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCfMexistsKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCfMexistsKey.ItemBytes %v", a)
+	}
+	if a, b := (CmsIncrbyItemsIncrement{ks: InitSlot}).ItemBytes([]byte("1")), (CmsIncrbyItemsIncrement{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CmsIncrbyItemsIncrement.ItemBytes %v", a)
+	}
+	if a, b := (SCmsIncrbyItemsIncrement{ks: InitSlot}).ItemBytes([]byte("1")), (SCmsIncrbyItemsIncrement{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCmsIncrbyItemsIncrement.ItemBytes %v", a)
+	}
+	if a, b := (CmsIncrbyKey{ks: InitSlot}).ItemBytes([]byte("1")), (CmsIncrbyKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CmsIncrbyKey.ItemBytes %v", a)
+	}
+	if a, b := (SCmsIncrbyKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCmsIncrbyKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCmsIncrbyKey.ItemBytes %v", a)
+	}
+	if a, b := (CmsQueryItem{ks: InitSlot}).ItemBytes([]byte("1")), (CmsQueryItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CmsQueryItem.ItemBytes %v", a)
+	}
+	if a, b := (SCmsQueryItem{ks: InitSlot}).ItemBytes([]byte("1")), (SCmsQueryItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCmsQueryItem.ItemBytes %v", a)
+	}
+	if a, b := (CmsQueryKey{ks: InitSlot}).ItemBytes([]byte("1")), (CmsQueryKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected CmsQueryKey.ItemBytes %v", a)
+	}
+	if a, b := (SCmsQueryKey{ks: InitSlot}).ItemBytes([]byte("1")), (SCmsQueryKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SCmsQueryKey.ItemBytes %v", a)
+	}
+	if a, b := (ConfigSetParameterValue{ks: InitSlot}).ParameterValueBytes("1", []byte("1")), (ConfigSetParameterValue{ks: InitSlot}).ParameterValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ConfigSetParameterValue.ParameterValueBytes %v", a)
+	}
+	if a, b := (SConfigSetParameterValue{ks: InitSlot}).ParameterValueBytes("1", []byte("1")), (SConfigSetParameterValue{ks: InitSlot}).ParameterValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SConfigSetParameterValue.ParameterValueBytes %v", a)
+	}
+	if a, b := (Echo{ks: InitSlot}).MessageBytes([]byte("1")), (Echo{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected Echo.MessageBytes %v", a)
+	}
+	if a, b := (SEcho{ks: InitSlot}).MessageBytes([]byte("1")), (SEcho{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEcho.MessageBytes %v", a)
+	}
+	if a, b := (EvalArg{ks: InitSlot}).ArgBytes([]byte("1")), (EvalArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalArg.ArgBytes %v", a)
+	}
+	if a, b := (SEvalArg{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalArg.ArgBytes %v", a)
+	}
+	if a, b := (EvalKey{ks: InitSlot}).ArgBytes([]byte("1")), (EvalKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalKey.ArgBytes %v", a)
+	}
+	if a, b := (SEvalKey{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalKey.ArgBytes %v", a)
+	}
+	if a, b := (EvalNumkeys{ks: InitSlot}).ArgBytes([]byte("1")), (EvalNumkeys{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalNumkeys.ArgBytes %v", a)
+	}
+	if a, b := (SEvalNumkeys{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalNumkeys{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalNumkeys.ArgBytes %v", a)
+	}
+	if a, b := (EvalRoArg{ks: InitSlot}).ArgBytes([]byte("1")), (EvalRoArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalRoArg.ArgBytes %v", a)
+	}
+	if a, b := (SEvalRoArg{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalRoArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalRoArg.ArgBytes %v", a)
+	}
+	if a, b := (EvalRoKey{ks: InitSlot}).ArgBytes([]byte("1")), (EvalRoKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalRoKey.ArgBytes %v", a)
+	}
+	if a, b := (SEvalRoKey{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalRoKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalRoKey.ArgBytes %v", a)
+	}
+	if a, b := (EvalshaArg{ks: InitSlot}).ArgBytes([]byte("1")), (EvalshaArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalshaArg.ArgBytes %v", a)
+	}
+	if a, b := (SEvalshaArg{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalshaArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalshaArg.ArgBytes %v", a)
+	}
+	if a, b := (EvalshaKey{ks: InitSlot}).ArgBytes([]byte("1")), (EvalshaKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalshaKey.ArgBytes %v", a)
+	}
+	if a, b := (SEvalshaKey{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalshaKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalshaKey.ArgBytes %v", a)
+	}
+	if a, b := (EvalshaNumkeys{ks: InitSlot}).ArgBytes([]byte("1")), (EvalshaNumkeys{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalshaNumkeys.ArgBytes %v", a)
+	}
+	if a, b := (SEvalshaNumkeys{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalshaNumkeys{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalshaNumkeys.ArgBytes %v", a)
+	}
+	if a, b := (EvalshaRoArg{ks: InitSlot}).ArgBytes([]byte("1")), (EvalshaRoArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalshaRoArg.ArgBytes %v", a)
+	}
+	if a, b := (SEvalshaRoArg{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalshaRoArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalshaRoArg.ArgBytes %v", a)
+	}
+	if a, b := (EvalshaRoKey{ks: InitSlot}).ArgBytes([]byte("1")), (EvalshaRoKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected EvalshaRoKey.ArgBytes %v", a)
+	}
+	if a, b := (SEvalshaRoKey{ks: InitSlot}).ArgBytes([]byte("1")), (SEvalshaRoKey{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SEvalshaRoKey.ArgBytes %v", a)
+	}
+	if a, b := (FtAggregateGroupbyReduceArg{ks: InitSlot}).ArgBytes([]byte("1")), (FtAggregateGroupbyReduceArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected FtAggregateGroupbyReduceArg.ArgBytes %v", a)
+	}
+	if a, b := (SFtAggregateGroupbyReduceArg{ks: InitSlot}).ArgBytes([]byte("1")), (SFtAggregateGroupbyReduceArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SFtAggregateGroupbyReduceArg.ArgBytes %v", a)
+	}
+	if a, b := (FtAggregateGroupbyReduceNargs{ks: InitSlot}).ArgBytes([]byte("1")), (FtAggregateGroupbyReduceNargs{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected FtAggregateGroupbyReduceNargs.ArgBytes %v", a)
+	}
+	if a, b := (SFtAggregateGroupbyReduceNargs{ks: InitSlot}).ArgBytes([]byte("1")), (SFtAggregateGroupbyReduceNargs{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SFtAggregateGroupbyReduceNargs.ArgBytes %v", a)
+	}
+	if a, b := (FtConfigGetOption{ks: InitSlot}).ValueBytes([]byte("1")), (FtConfigGetOption{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected FtConfigGetOption.ValueBytes %v", a)
+	}
+	if a, b := (SFtConfigGetOption{ks: InitSlot}).ValueBytes([]byte("1")), (SFtConfigGetOption{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SFtConfigGetOption.ValueBytes %v", a)
+	}
+	if a, b := (GeoaddLongitudeLatitudeMember{ks: InitSlot}).LongitudeLatitudeMemberBytes(1, 1, []byte("1")), (GeoaddLongitudeLatitudeMember{ks: InitSlot}).LongitudeLatitudeMember(1, 1, "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeoaddLongitudeLatitudeMember.LongitudeLatitudeMemberBytes %v", a)
+	}
+	if a, b := (SGeoaddLongitudeLatitudeMember{ks: InitSlot}).LongitudeLatitudeMemberBytes(1, 1, []byte("1")), (SGeoaddLongitudeLatitudeMember{ks: InitSlot}).LongitudeLatitudeMember(1, 1, "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeoaddLongitudeLatitudeMember.LongitudeLatitudeMemberBytes %v", a)
+	}
+	if a, b := (GeohashKey{ks: InitSlot}).MemberBytes([]byte("1")), (GeohashKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeohashKey.MemberBytes %v", a)
+	}
+	if a, b := (SGeohashKey{ks: InitSlot}).MemberBytes([]byte("1")), (SGeohashKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeohashKey.MemberBytes %v", a)
+	}
+	if a, b := (GeohashMember{ks: InitSlot}).MemberBytes([]byte("1")), (GeohashMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeohashMember.MemberBytes %v", a)
+	}
+	if a, b := (SGeohashMember{ks: InitSlot}).MemberBytes([]byte("1")), (SGeohashMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeohashMember.MemberBytes %v", a)
+	}
+	if a, b := (GeoposKey{ks: InitSlot}).MemberBytes([]byte("1")), (GeoposKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeoposKey.MemberBytes %v", a)
+	}
+	if a, b := (SGeoposKey{ks: InitSlot}).MemberBytes([]byte("1")), (SGeoposKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeoposKey.MemberBytes %v", a)
+	}
+	if a, b := (GeoposMember{ks: InitSlot}).MemberBytes([]byte("1")), (GeoposMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeoposMember.MemberBytes %v", a)
+	}
+	if a, b := (SGeoposMember{ks: InitSlot}).MemberBytes([]byte("1")), (SGeoposMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeoposMember.MemberBytes %v", a)
+	}
+	if a, b := (GeoradiusbymemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (GeoradiusbymemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeoradiusbymemberKey.MemberBytes %v", a)
+	}
+	if a, b := (SGeoradiusbymemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (SGeoradiusbymemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeoradiusbymemberKey.MemberBytes %v", a)
+	}
+	if a, b := (GeoradiusbymemberRoKey{ks: InitSlot}).MemberBytes([]byte("1")), (GeoradiusbymemberRoKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeoradiusbymemberRoKey.MemberBytes %v", a)
+	}
+	if a, b := (SGeoradiusbymemberRoKey{ks: InitSlot}).MemberBytes([]byte("1")), (SGeoradiusbymemberRoKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeoradiusbymemberRoKey.MemberBytes %v", a)
+	}
+	if a, b := (GeosearchKey{ks: InitSlot}).FrommemberBytes([]byte("1")), (GeosearchKey{ks: InitSlot}).Frommember("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeosearchKey.FrommemberBytes %v", a)
+	}
+	if a, b := (SGeosearchKey{ks: InitSlot}).FrommemberBytes([]byte("1")), (SGeosearchKey{ks: InitSlot}).Frommember("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeosearchKey.FrommemberBytes %v", a)
+	}
+	if a, b := (GeosearchstoreSource{ks: InitSlot}).FrommemberBytes([]byte("1")), (GeosearchstoreSource{ks: InitSlot}).Frommember("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GeosearchstoreSource.FrommemberBytes %v", a)
+	}
+	if a, b := (SGeosearchstoreSource{ks: InitSlot}).FrommemberBytes([]byte("1")), (SGeosearchstoreSource{ks: InitSlot}).Frommember("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGeosearchstoreSource.FrommemberBytes %v", a)
+	}
+	if a, b := (GetsetKey{ks: InitSlot}).ValueBytes([]byte("1")), (GetsetKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GetsetKey.ValueBytes %v", a)
+	}
+	if a, b := (SGetsetKey{ks: InitSlot}).ValueBytes([]byte("1")), (SGetsetKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGetsetKey.ValueBytes %v", a)
+	}
+	if a, b := (GraphConfigSetName{ks: InitSlot}).ValueBytes([]byte("1")), (GraphConfigSetName{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected GraphConfigSetName.ValueBytes %v", a)
+	}
+	if a, b := (SGraphConfigSetName{ks: InitSlot}).ValueBytes([]byte("1")), (SGraphConfigSetName{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SGraphConfigSetName.ValueBytes %v", a)
+	}
+	if a, b := (HmsetFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (HmsetFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected HmsetFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (SHmsetFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (SHmsetFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SHmsetFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (HsetFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (HsetFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected HsetFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (SHsetFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (SHsetFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SHsetFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (HsetnxField{ks: InitSlot}).ValueBytes([]byte("1")), (HsetnxField{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected HsetnxField.ValueBytes %v", a)
+	}
+	if a, b := (SHsetnxField{ks: InitSlot}).ValueBytes([]byte("1")), (SHsetnxField{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SHsetnxField.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrappendKey{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrappendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrappendKey.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrappendKey{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrappendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrappendKey.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrappendPath{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrappendPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrappendPath.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrappendPath{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrappendPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrappendPath.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrappendValue{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrappendValue{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrappendValue.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrappendValue{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrappendValue{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrappendValue.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrindexPath{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrindexPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrindexPath.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrindexPath{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrindexPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrindexPath.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrinsertIndex{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrinsertIndex{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrinsertIndex.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrinsertIndex{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrinsertIndex{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrinsertIndex.ValueBytes %v", a)
+	}
+	if a, b := (JsonArrinsertValue{ks: InitSlot}).ValueBytes([]byte("1")), (JsonArrinsertValue{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonArrinsertValue.ValueBytes %v", a)
+	}
+	if a, b := (SJsonArrinsertValue{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonArrinsertValue{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonArrinsertValue.ValueBytes %v", a)
+	}
+	if a, b := (JsonSetPath{ks: InitSlot}).ValueBytes([]byte("1")), (JsonSetPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonSetPath.ValueBytes %v", a)
+	}
+	if a, b := (SJsonSetPath{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonSetPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonSetPath.ValueBytes %v", a)
+	}
+	if a, b := (JsonStrappendKey{ks: InitSlot}).ValueBytes([]byte("1")), (JsonStrappendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonStrappendKey.ValueBytes %v", a)
+	}
+	if a, b := (SJsonStrappendKey{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonStrappendKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonStrappendKey.ValueBytes %v", a)
+	}
+	if a, b := (JsonStrappendPath{ks: InitSlot}).ValueBytes([]byte("1")), (JsonStrappendPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected JsonStrappendPath.ValueBytes %v", a)
+	}
+	if a, b := (SJsonStrappendPath{ks: InitSlot}).ValueBytes([]byte("1")), (SJsonStrappendPath{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SJsonStrappendPath.ValueBytes %v", a)
+	}
+	if a, b := (LinsertPivot{ks: InitSlot}).ElementBytes([]byte("1")), (LinsertPivot{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LinsertPivot.ElementBytes %v", a)
+	}
+	if a, b := (SLinsertPivot{ks: InitSlot}).ElementBytes([]byte("1")), (SLinsertPivot{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLinsertPivot.ElementBytes %v", a)
+	}
+	if a, b := (LposKey{ks: InitSlot}).ElementBytes([]byte("1")), (LposKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LposKey.ElementBytes %v", a)
+	}
+	if a, b := (SLposKey{ks: InitSlot}).ElementBytes([]byte("1")), (SLposKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLposKey.ElementBytes %v", a)
+	}
+	if a, b := (LpushElement{ks: InitSlot}).ElementBytes([]byte("1")), (LpushElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LpushElement.ElementBytes %v", a)
+	}
+	if a, b := (SLpushElement{ks: InitSlot}).ElementBytes([]byte("1")), (SLpushElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLpushElement.ElementBytes %v", a)
+	}
+	if a, b := (LpushKey{ks: InitSlot}).ElementBytes([]byte("1")), (LpushKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LpushKey.ElementBytes %v", a)
+	}
+	if a, b := (SLpushKey{ks: InitSlot}).ElementBytes([]byte("1")), (SLpushKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLpushKey.ElementBytes %v", a)
+	}
+	if a, b := (LpushxElement{ks: InitSlot}).ElementBytes([]byte("1")), (LpushxElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LpushxElement.ElementBytes %v", a)
+	}
+	if a, b := (SLpushxElement{ks: InitSlot}).ElementBytes([]byte("1")), (SLpushxElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLpushxElement.ElementBytes %v", a)
+	}
+	if a, b := (LpushxKey{ks: InitSlot}).ElementBytes([]byte("1")), (LpushxKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LpushxKey.ElementBytes %v", a)
+	}
+	if a, b := (SLpushxKey{ks: InitSlot}).ElementBytes([]byte("1")), (SLpushxKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLpushxKey.ElementBytes %v", a)
+	}
+	if a, b := (LremCount{ks: InitSlot}).ElementBytes([]byte("1")), (LremCount{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LremCount.ElementBytes %v", a)
+	}
+	if a, b := (SLremCount{ks: InitSlot}).ElementBytes([]byte("1")), (SLremCount{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLremCount.ElementBytes %v", a)
+	}
+	if a, b := (LsetIndex{ks: InitSlot}).ElementBytes([]byte("1")), (LsetIndex{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected LsetIndex.ElementBytes %v", a)
+	}
+	if a, b := (SLsetIndex{ks: InitSlot}).ElementBytes([]byte("1")), (SLsetIndex{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SLsetIndex.ElementBytes %v", a)
+	}
+	if a, b := (ModuleLoadArg{ks: InitSlot}).ArgBytes([]byte("1")), (ModuleLoadArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ModuleLoadArg.ArgBytes %v", a)
+	}
+	if a, b := (SModuleLoadArg{ks: InitSlot}).ArgBytes([]byte("1")), (SModuleLoadArg{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SModuleLoadArg.ArgBytes %v", a)
+	}
+	if a, b := (ModuleLoadPath{ks: InitSlot}).ArgBytes([]byte("1")), (ModuleLoadPath{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ModuleLoadPath.ArgBytes %v", a)
+	}
+	if a, b := (SModuleLoadPath{ks: InitSlot}).ArgBytes([]byte("1")), (SModuleLoadPath{ks: InitSlot}).Arg("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SModuleLoadPath.ArgBytes %v", a)
+	}
+	if a, b := (MsetKeyValue{ks: InitSlot}).KeyValueBytes("1", []byte("1")), (MsetKeyValue{ks: InitSlot}).KeyValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected MsetKeyValue.KeyValueBytes %v", a)
+	}
+	if a, b := (SMsetKeyValue{ks: InitSlot}).KeyValueBytes("1", []byte("1")), (SMsetKeyValue{ks: InitSlot}).KeyValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SMsetKeyValue.KeyValueBytes %v", a)
+	}
+	if a, b := (MsetnxKeyValue{ks: InitSlot}).KeyValueBytes("1", []byte("1")), (MsetnxKeyValue{ks: InitSlot}).KeyValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected MsetnxKeyValue.KeyValueBytes %v", a)
+	}
+	if a, b := (SMsetnxKeyValue{ks: InitSlot}).KeyValueBytes("1", []byte("1")), (SMsetnxKeyValue{ks: InitSlot}).KeyValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SMsetnxKeyValue.KeyValueBytes %v", a)
+	}
+	if a, b := (PfaddElement{ks: InitSlot}).ElementBytes([]byte("1")), (PfaddElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected PfaddElement.ElementBytes %v", a)
+	}
+	if a, b := (SPfaddElement{ks: InitSlot}).ElementBytes([]byte("1")), (SPfaddElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SPfaddElement.ElementBytes %v", a)
+	}
+	if a, b := (PfaddKey{ks: InitSlot}).ElementBytes([]byte("1")), (PfaddKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected PfaddKey.ElementBytes %v", a)
+	}
+	if a, b := (SPfaddKey{ks: InitSlot}).ElementBytes([]byte("1")), (SPfaddKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SPfaddKey.ElementBytes %v", a)
+	}
+	if a, b := (Ping{ks: InitSlot}).MessageBytes([]byte("1")), (Ping{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected Ping.MessageBytes %v", a)
+	}
+	if a, b := (SPing{ks: InitSlot}).MessageBytes([]byte("1")), (SPing{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SPing.MessageBytes %v", a)
+	}
+	if a, b := (PsetexMilliseconds{ks: InitSlot}).ValueBytes([]byte("1")), (PsetexMilliseconds{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected PsetexMilliseconds.ValueBytes %v", a)
+	}
+	if a, b := (SPsetexMilliseconds{ks: InitSlot}).ValueBytes([]byte("1")), (SPsetexMilliseconds{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SPsetexMilliseconds.ValueBytes %v", a)
+	}
+	if a, b := (PublishChannel{ks: InitSlot}).MessageBytes([]byte("1")), (PublishChannel{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected PublishChannel.MessageBytes %v", a)
+	}
+	if a, b := (SPublishChannel{ks: InitSlot}).MessageBytes([]byte("1")), (SPublishChannel{ks: InitSlot}).Message("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SPublishChannel.MessageBytes %v", a)
+	}
+	if a, b := (RestoreTtl{ks: InitSlot}).SerializedValueBytes([]byte("1")), (RestoreTtl{ks: InitSlot}).SerializedValue("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected RestoreTtl.SerializedValueBytes %v", a)
+	}
+	if a, b := (SRestoreTtl{ks: InitSlot}).SerializedValueBytes([]byte("1")), (SRestoreTtl{ks: InitSlot}).SerializedValue("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SRestoreTtl.SerializedValueBytes %v", a)
+	}
+	if a, b := (RpushElement{ks: InitSlot}).ElementBytes([]byte("1")), (RpushElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected RpushElement.ElementBytes %v", a)
+	}
+	if a, b := (SRpushElement{ks: InitSlot}).ElementBytes([]byte("1")), (SRpushElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SRpushElement.ElementBytes %v", a)
+	}
+	if a, b := (RpushKey{ks: InitSlot}).ElementBytes([]byte("1")), (RpushKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected RpushKey.ElementBytes %v", a)
+	}
+	if a, b := (SRpushKey{ks: InitSlot}).ElementBytes([]byte("1")), (SRpushKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SRpushKey.ElementBytes %v", a)
+	}
+	if a, b := (RpushxElement{ks: InitSlot}).ElementBytes([]byte("1")), (RpushxElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected RpushxElement.ElementBytes %v", a)
+	}
+	if a, b := (SRpushxElement{ks: InitSlot}).ElementBytes([]byte("1")), (SRpushxElement{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SRpushxElement.ElementBytes %v", a)
+	}
+	if a, b := (RpushxKey{ks: InitSlot}).ElementBytes([]byte("1")), (RpushxKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected RpushxKey.ElementBytes %v", a)
+	}
+	if a, b := (SRpushxKey{ks: InitSlot}).ElementBytes([]byte("1")), (SRpushxKey{ks: InitSlot}).Element("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SRpushxKey.ElementBytes %v", a)
+	}
+	if a, b := (SaddKey{ks: InitSlot}).MemberBytes([]byte("1")), (SaddKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SaddKey.MemberBytes %v", a)
+	}
+	if a, b := (SSaddKey{ks: InitSlot}).MemberBytes([]byte("1")), (SSaddKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSaddKey.MemberBytes %v", a)
+	}
+	if a, b := (SaddMember{ks: InitSlot}).MemberBytes([]byte("1")), (SaddMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SaddMember.MemberBytes %v", a)
+	}
+	if a, b := (SSaddMember{ks: InitSlot}).MemberBytes([]byte("1")), (SSaddMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSaddMember.MemberBytes %v", a)
+	}
+	if a, b := (SetKey{ks: InitSlot}).ValueBytes([]byte("1")), (SetKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SetKey.ValueBytes %v", a)
+	}
+	if a, b := (SSetKey{ks: InitSlot}).ValueBytes([]byte("1")), (SSetKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSetKey.ValueBytes %v", a)
+	}
+	if a, b := (SetexSeconds{ks: InitSlot}).ValueBytes([]byte("1")), (SetexSeconds{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SetexSeconds.ValueBytes %v", a)
+	}
+	if a, b := (SSetexSeconds{ks: InitSlot}).ValueBytes([]byte("1")), (SSetexSeconds{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSetexSeconds.ValueBytes %v", a)
+	}
+	if a, b := (SetnxKey{ks: InitSlot}).ValueBytes([]byte("1")), (SetnxKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SetnxKey.ValueBytes %v", a)
+	}
+	if a, b := (SSetnxKey{ks: InitSlot}).ValueBytes([]byte("1")), (SSetnxKey{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSetnxKey.ValueBytes %v", a)
+	}
+	if a, b := (SetrangeOffset{ks: InitSlot}).ValueBytes([]byte("1")), (SetrangeOffset{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SetrangeOffset.ValueBytes %v", a)
+	}
+	if a, b := (SSetrangeOffset{ks: InitSlot}).ValueBytes([]byte("1")), (SSetrangeOffset{ks: InitSlot}).Value("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSetrangeOffset.ValueBytes %v", a)
+	}
+	if a, b := (SismemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (SismemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SismemberKey.MemberBytes %v", a)
+	}
+	if a, b := (SSismemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (SSismemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSismemberKey.MemberBytes %v", a)
+	}
+	if a, b := (SmismemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (SmismemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SmismemberKey.MemberBytes %v", a)
+	}
+	if a, b := (SSmismemberKey{ks: InitSlot}).MemberBytes([]byte("1")), (SSmismemberKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSmismemberKey.MemberBytes %v", a)
+	}
+	if a, b := (SmismemberMember{ks: InitSlot}).MemberBytes([]byte("1")), (SmismemberMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SmismemberMember.MemberBytes %v", a)
+	}
+	if a, b := (SSmismemberMember{ks: InitSlot}).MemberBytes([]byte("1")), (SSmismemberMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSmismemberMember.MemberBytes %v", a)
+	}
+	if a, b := (SmoveDestination{ks: InitSlot}).MemberBytes([]byte("1")), (SmoveDestination{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SmoveDestination.MemberBytes %v", a)
+	}
+	if a, b := (SSmoveDestination{ks: InitSlot}).MemberBytes([]byte("1")), (SSmoveDestination{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSmoveDestination.MemberBytes %v", a)
+	}
+	if a, b := (SremKey{ks: InitSlot}).MemberBytes([]byte("1")), (SremKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SremKey.MemberBytes %v", a)
+	}
+	if a, b := (SSremKey{ks: InitSlot}).MemberBytes([]byte("1")), (SSremKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSremKey.MemberBytes %v", a)
+	}
+	if a, b := (SremMember{ks: InitSlot}).MemberBytes([]byte("1")), (SremMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SremMember.MemberBytes %v", a)
+	}
+	if a, b := (SSremMember{ks: InitSlot}).MemberBytes([]byte("1")), (SSremMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SSremMember.MemberBytes %v", a)
+	}
+	if a, b := (TopkIncrbyItemsIncrement{ks: InitSlot}).ItemBytes([]byte("1")), (TopkIncrbyItemsIncrement{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TopkIncrbyItemsIncrement.ItemBytes %v", a)
+	}
+	if a, b := (STopkIncrbyItemsIncrement{ks: InitSlot}).ItemBytes([]byte("1")), (STopkIncrbyItemsIncrement{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STopkIncrbyItemsIncrement.ItemBytes %v", a)
+	}
+	if a, b := (TopkIncrbyKey{ks: InitSlot}).ItemBytes([]byte("1")), (TopkIncrbyKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TopkIncrbyKey.ItemBytes %v", a)
+	}
+	if a, b := (STopkIncrbyKey{ks: InitSlot}).ItemBytes([]byte("1")), (STopkIncrbyKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STopkIncrbyKey.ItemBytes %v", a)
+	}
+	if a, b := (TopkQueryItem{ks: InitSlot}).ItemBytes([]byte("1")), (TopkQueryItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TopkQueryItem.ItemBytes %v", a)
+	}
+	if a, b := (STopkQueryItem{ks: InitSlot}).ItemBytes([]byte("1")), (STopkQueryItem{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STopkQueryItem.ItemBytes %v", a)
+	}
+	if a, b := (TopkQueryKey{ks: InitSlot}).ItemBytes([]byte("1")), (TopkQueryKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TopkQueryKey.ItemBytes %v", a)
+	}
+	if a, b := (STopkQueryKey{ks: InitSlot}).ItemBytes([]byte("1")), (STopkQueryKey{ks: InitSlot}).Item("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STopkQueryKey.ItemBytes %v", a)
+	}
+	if a, b := (TsAddLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (TsAddLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TsAddLabels.LabelsBytes %v", a)
+	}
+	if a, b := (STsAddLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (STsAddLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STsAddLabels.LabelsBytes %v", a)
+	}
+	if a, b := (TsAlterLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (TsAlterLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TsAlterLabels.LabelsBytes %v", a)
+	}
+	if a, b := (STsAlterLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (STsAlterLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STsAlterLabels.LabelsBytes %v", a)
+	}
+	if a, b := (TsCreateLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (TsCreateLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TsCreateLabels.LabelsBytes %v", a)
+	}
+	if a, b := (STsCreateLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (STsCreateLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STsCreateLabels.LabelsBytes %v", a)
+	}
+	if a, b := (TsDecrbyLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (TsDecrbyLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TsDecrbyLabels.LabelsBytes %v", a)
+	}
+	if a, b := (STsDecrbyLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (STsDecrbyLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STsDecrbyLabels.LabelsBytes %v", a)
+	}
+	if a, b := (TsIncrbyLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (TsIncrbyLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected TsIncrbyLabels.LabelsBytes %v", a)
+	}
+	if a, b := (STsIncrbyLabels{ks: InitSlot}).LabelsBytes("1", []byte("1")), (STsIncrbyLabels{ks: InitSlot}).Labels("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected STsIncrbyLabels.LabelsBytes %v", a)
+	}
+	if a, b := (XaddFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (XaddFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected XaddFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (SXaddFieldValue{ks: InitSlot}).FieldValueBytes("1", []byte("1")), (SXaddFieldValue{ks: InitSlot}).FieldValue("1", "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SXaddFieldValue.FieldValueBytes %v", a)
+	}
+	if a, b := (ZaddScoreMember{ks: InitSlot}).ScoreMemberBytes(1, []byte("1")), (ZaddScoreMember{ks: InitSlot}).ScoreMember(1, "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZaddScoreMember.ScoreMemberBytes %v", a)
+	}
+	if a, b := (SZaddScoreMember{ks: InitSlot}).ScoreMemberBytes(1, []byte("1")), (SZaddScoreMember{ks: InitSlot}).ScoreMember(1, "1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZaddScoreMember.ScoreMemberBytes %v", a)
+	}
+	if a, b := (ZincrbyIncrement{ks: InitSlot}).MemberBytes([]byte("1")), (ZincrbyIncrement{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZincrbyIncrement.MemberBytes %v", a)
+	}
+	if a, b := (SZincrbyIncrement{ks: InitSlot}).MemberBytes([]byte("1")), (SZincrbyIncrement{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZincrbyIncrement.MemberBytes %v", a)
+	}
+	if a, b := (ZmscoreKey{ks: InitSlot}).MemberBytes([]byte("1")), (ZmscoreKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZmscoreKey.MemberBytes %v", a)
+	}
+	if a, b := (SZmscoreKey{ks: InitSlot}).MemberBytes([]byte("1")), (SZmscoreKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZmscoreKey.MemberBytes %v", a)
+	}
+	if a, b := (ZmscoreMember{ks: InitSlot}).MemberBytes([]byte("1")), (ZmscoreMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZmscoreMember.MemberBytes %v", a)
+	}
+	if a, b := (SZmscoreMember{ks: InitSlot}).MemberBytes([]byte("1")), (SZmscoreMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZmscoreMember.MemberBytes %v", a)
+	}
+	if a, b := (ZrankKey{ks: InitSlot}).MemberBytes([]byte("1")), (ZrankKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZrankKey.MemberBytes %v", a)
+	}
+	if a, b := (SZrankKey{ks: InitSlot}).MemberBytes([]byte("1")), (SZrankKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZrankKey.MemberBytes %v", a)
+	}
+	if a, b := (ZremKey{ks: InitSlot}).MemberBytes([]byte("1")), (ZremKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZremKey.MemberBytes %v", a)
+	}
+	if a, b := (SZremKey{ks: InitSlot}).MemberBytes([]byte("1")), (SZremKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZremKey.MemberBytes %v", a)
+	}
+	if a, b := (ZremMember{ks: InitSlot}).MemberBytes([]byte("1")), (ZremMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZremMember.MemberBytes %v", a)
+	}
+	if a, b := (SZremMember{ks: InitSlot}).MemberBytes([]byte("1")), (SZremMember{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZremMember.MemberBytes %v", a)
+	}
+	if a, b := (ZrevrankKey{ks: InitSlot}).MemberBytes([]byte("1")), (ZrevrankKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZrevrankKey.MemberBytes %v", a)
+	}
+	if a, b := (SZrevrankKey{ks: InitSlot}).MemberBytes([]byte("1")), (SZrevrankKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZrevrankKey.MemberBytes %v", a)
+	}
+	if a, b := (ZscoreKey{ks: InitSlot}).MemberBytes([]byte("1")), (ZscoreKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected ZscoreKey.MemberBytes %v", a)
+	}
+	if a, b := (SZscoreKey{ks: InitSlot}).MemberBytes([]byte("1")), (SZscoreKey{ks: InitSlot}).Member("1"); !reflect.DeepEqual(a, b) {
+		t.Fatalf("unexpected SZscoreKey.MemberBytes %v", a)
+	}
+}
+
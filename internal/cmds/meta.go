@@ -0,0 +1,49 @@
+package cmds
+
+import "strings"
+
+// Meta is the metadata of a command from the command specs.
+type Meta struct {
+	// Name is the command name in upper case, including the subcommand if any, ex. CLIENT PAUSE.
+	Name string
+	// Since is the version introducing the command. It is the module version if the Module is not empty.
+	Since           string
+	DeprecatedSince string
+	Group           string
+	// Module is the name of the module providing the command, ex. json or search. It is empty for the redis core commands.
+	Module     string
+	Complexity string
+	// FirstKey, LastKey and KeyStep are the positions of the keys in the command, derived from the key specs like the COMMAND INFO.
+	// The LastKey is negative if it counts from the end, and they are all 0 if the command has no key at a fixed position.
+	FirstKey int
+	LastKey  int
+	KeyStep  int
+	// MovableKeys is true if the positions of some keys depend on the other arguments, such as the numkeys of the EVAL.
+	MovableKeys bool
+}
+
+// containers are the first tokens of the commands with subcommands, ex. CLIENT of the CLIENT PAUSE
+var containers = map[string]bool{}
+
+func init() {
+	for name := range metas {
+		if i := strings.IndexByte(name, ' '); i > 0 {
+			containers[name[:i]] = true
+		}
+	}
+}
+
+// LookupMeta returns the Meta of the command, ex. LookupMeta([]string{"CLIENT", "PAUSE", "10"}).
+func LookupMeta(cmd []string) (m Meta, ok bool) {
+	if len(cmd) == 0 {
+		return m, false
+	}
+	name := strings.ToUpper(cmd[0])
+	if len(cmd) > 1 && containers[name] {
+		if m, ok = metas[name+" "+strings.ToUpper(cmd[1])]; ok {
+			return m, ok
+		}
+	}
+	m, ok = metas[name]
+	return m, ok
+}
@@ -0,0 +1,15 @@
+package cmds
+
+import "testing"
+
+func TestLookupMeta(t *testing.T) {
+	if m, ok := LookupMeta([]string{"ACL", "SETUSER", "a"}); !ok || m.Name != "ACL SETUSER" || m.Since != "6.0.0" {
+		t.Fatalf("unexpected meta %v %v", m, ok)
+	}
+	if m, ok := LookupMeta([]string{"set", "a", "b"}); !ok || m.Name != "SET" {
+		t.Fatalf("unexpected meta %v %v", m, ok)
+	}
+	if _, ok := LookupMeta(nil); ok {
+		t.Fatalf("unexpected meta of empty command")
+	}
+}
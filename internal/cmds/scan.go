@@ -0,0 +1,20 @@
+package cmds
+
+import "strconv"
+
+// WithCursor returns a copy of the SCAN, HSCAN, SSCAN or ZSCAN command with its cursor replaced.
+// The copy is not taken from the builder pool, so it can be reused across calls.
+func (c *Completed) WithCursor(cursor int64) Completed {
+	cs := make([]string, len(c.cs))
+	copy(cs, c.cs)
+	i := 2 // the cursor follows the key except for the SCAN
+	if cs[0] == "SCAN" {
+		i = 1
+	}
+	cs[i] = strconv.FormatUint(uint64(cursor), 10)
+	return Completed{cs: cs, cf: c.cf, ks: c.ks}
+}
+
+func (c *SCompleted) WithCursor(cursor int64) SCompleted {
+	return SCompleted((*Completed)(c).WithCursor(cursor))
+}
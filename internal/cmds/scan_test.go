@@ -0,0 +1,21 @@
+package cmds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWithCursor(t *testing.T) {
+	b := NewSBuilder()
+	scan := b.Scan().Cursor(0).Match("a*").Build()
+	if c := scan.WithCursor(-1); !reflect.DeepEqual(c.Commands(), []string{"SCAN", "18446744073709551615", "MATCH", "a*"}) {
+		t.Fatalf("unexpected commands %v", c.Commands())
+	}
+	if cs := scan.Commands(); cs[1] != "0" {
+		t.Fatalf("the original command should not be modified %v", cs)
+	}
+	hscan := b.Hscan().Key("k").Cursor(0).Build()
+	if c := hscan.WithCursor(5); !reflect.DeepEqual(c.Commands(), []string{"HSCAN", "k", "5"}) || c.Slot() != hscan.Slot() || !(*Completed)(&c).IsReadOnly() {
+		t.Fatalf("unexpected command %v", c)
+	}
+}
@@ -2,7 +2,20 @@ package cmds
 
 // https://redis.io/topics/cluster-spec
 
+// Slot returns the cluster hash slot of the key, respecting the hash tag
+func Slot(key string) uint16 {
+	return slot(key)
+}
+
 func slot(key string) uint16 {
+	if tag, ok := HashTag(key); ok {
+		return crc16(tag) & 16383
+	}
+	return crc16(key) & 16383
+}
+
+// HashTag returns the non-empty part between the first { and the following } of the key, which is hashed instead of the whole key
+func HashTag(key string) (tag string, ok bool) {
 	var s, e int
 	for ; s < len(key); s++ {
 		if key[s] == '{' {
@@ -10,7 +23,7 @@ func slot(key string) uint16 {
 		}
 	}
 	if s == len(key) {
-		return crc16(key) & 16383
+		return "", false
 	}
 	for e = s + 1; e < len(key); e++ {
 		if key[e] == '}' {
@@ -18,9 +31,9 @@ func slot(key string) uint16 {
 		}
 	}
 	if e == len(key) || e == s+1 {
-		return crc16(key) & 16383
+		return "", false
 	}
-	return crc16(key[s+1:e]) & 16383
+	return key[s+1 : e], true
 }
 
 /*
@@ -0,0 +1,72 @@
+package cmds
+
+// Split returns a SplitBuilder whose commands are allowed to have keys in different slots.
+func (b *SBuilder) Split() SplitBuilder {
+	return SplitBuilder{b: b}
+}
+
+// SplitBuilder builds the multi key commands that can be split by slots and merged back.
+// The Slot() of the built command is MultiSlot if its keys are in different slots, and it is InitSlot or
+// the slot of its keys otherwise, just like the ones built by the SBuilder.
+type SplitBuilder struct {
+	b *SBuilder
+}
+
+func (s SplitBuilder) Mget() SMget {
+	c := s.b.Mget()
+	c.ks |= splitTag
+	return c
+}
+
+func (s SplitBuilder) Mset() SMset {
+	c := s.b.Mset()
+	c.ks |= splitTag
+	return c
+}
+
+func (s SplitBuilder) Del() SDel {
+	c := s.b.Del()
+	c.ks |= splitTag
+	return c
+}
+
+func (s SplitBuilder) Exists() SExists {
+	c := s.b.Exists()
+	c.ks |= splitTag
+	return c
+}
+
+func (s SplitBuilder) Unlink() SUnlink {
+	c := s.b.Unlink()
+	c.ks |= splitTag
+	return c
+}
+
+func (s SplitBuilder) Touch() STouch {
+	c := s.b.Touch()
+	c.ks |= splitTag
+	return c
+}
+
+// Split splits the MultiSlot command into the ones of single slot. The indexes are the positions of the keys of
+// each part in the original command, counting from 0. The keys of MSET are counted with their values as pairs.
+func (c *SCompleted) Split() (parts []SCompleted, indexes [][]int) {
+	step := 1
+	if c.cs[0] == "MSET" {
+		step = 2
+	}
+	pos := make(map[uint16]int)
+	for i, k := 1, 0; i+step <= len(c.cs); i, k = i+step, k+1 {
+		s := slot(c.cs[i])
+		p, ok := pos[s]
+		if !ok {
+			p = len(parts)
+			pos[s] = p
+			parts = append(parts, SCompleted{cs: []string{c.cs[0]}, cf: c.cf, ks: s})
+			indexes = append(indexes, nil)
+		}
+		parts[p].cs = append(parts[p].cs, c.cs[i:i+step]...)
+		indexes[p] = append(indexes[p], k)
+	}
+	return parts, indexes
+}
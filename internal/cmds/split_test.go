@@ -0,0 +1,56 @@
+package cmds
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitBuilder(t *testing.T) {
+	b := NewSBuilder()
+	if c := b.Split().Mget().Key("a", "b").Build(); c.Slot() != MultiSlot {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Split().Del().Key("{a}1", "{a}2").Build(); c.Slot() != slot("a") {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Split().Exists().Key("a").Build(); c.Slot() != slot("a") {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Split().Touch().Key("a", "b", "a").Build(); c.Slot() != MultiSlot {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	if c := b.Split().Unlink().Key("a", "b").Build(); c.Slot() != MultiSlot {
+		t.Fatalf("unexpected slot %v", c.Slot())
+	}
+	defer func() {
+		if r := recover(); r != multiKeySlotErr {
+			t.Fatalf("unexpected recover %v", r)
+		}
+	}()
+	b.Mget().Key("a", "b")
+}
+
+func TestSplit(t *testing.T) {
+	b := NewSBuilder()
+	c := b.Split().Mget().Key("a", "b", "{a}1", "c").Build()
+	parts, indexes := c.Split()
+	if !reflect.DeepEqual(indexes, [][]int{{0, 2}, {1}, {3}}) {
+		t.Fatalf("unexpected indexes %v", indexes)
+	}
+	for i, cs := range [][]string{{"MGET", "a", "{a}1"}, {"MGET", "b"}, {"MGET", "c"}} {
+		if !reflect.DeepEqual(parts[i].Commands(), cs) || parts[i].Slot() != slot(cs[1]) || !(*Completed)(&parts[i]).IsReadOnly() {
+			t.Fatalf("unexpected part %v", parts[i])
+		}
+	}
+
+	c = b.Split().Mset().KeyValue().KeyValue("a", "1").KeyValue("b", "2").KeyValue("{a}1", "3").Build()
+	parts, indexes = c.Split()
+	if !reflect.DeepEqual(indexes, [][]int{{0, 2}, {1}}) {
+		t.Fatalf("unexpected indexes %v", indexes)
+	}
+	for i, cs := range [][]string{{"MSET", "a", "1", "{a}1", "3"}, {"MSET", "b", "2"}} {
+		if !reflect.DeepEqual(parts[i].Commands(), cs) || parts[i].Slot() != slot(cs[1]) {
+			t.Fatalf("unexpected part %v", parts[i])
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package mock
 
 import (
+	"context"
+	"io"
 	"time"
 
 	"github.com/rueian/rueidis/internal/cmds"
@@ -8,12 +10,13 @@ import (
 )
 
 type Wire struct {
-	DoFn      func(cmd cmds.Completed) proto.Result
-	DoCacheFn func(cmd cmds.Cacheable, ttl time.Duration) proto.Result
-	DoMultiFn func(multi ...cmds.Completed) []proto.Result
-	InfoFn    func() map[string]proto.Message
-	ErrorFn   func() error
-	CloseFn   func()
+	DoFn       func(cmd cmds.Completed) proto.Result
+	DoCacheFn  func(cmd cmds.Cacheable, ttl time.Duration) proto.Result
+	DoMultiFn  func(multi ...cmds.Completed) []proto.Result
+	DoStreamFn func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error)
+	InfoFn     func() map[string]proto.Message
+	ErrorFn    func() error
+	CloseFn    func()
 }
 
 func (m *Wire) Do(cmd cmds.Completed) proto.Result {
@@ -37,6 +40,13 @@ func (m *Wire) DoMulti(multi ...cmds.Completed) []proto.Result {
 	return nil
 }
 
+func (m *Wire) DoStream(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+	if m.DoStreamFn != nil {
+		return m.DoStreamFn(ctx, cmd, w)
+	}
+	return 0, nil
+}
+
 func (m *Wire) Info() map[string]proto.Message {
 	if m.InfoFn != nil {
 		return m.InfoFn()
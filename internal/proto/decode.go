@@ -0,0 +1,476 @@
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+var ErrDecodeTarget = errors.New("DecodeInto target should be a non-nil pointer to a struct")
+
+// ZScore is a member with its score, replied by sorted set commands WITHSCORES
+type ZScore struct {
+	Member string
+	Score  float64
+}
+
+// XRangeEntry is a stream entry replied by XRANGE, XREVRANGE, XREAD and XREADGROUP.
+// The FieldValues is nil if the entry is deleted.
+type XRangeEntry struct {
+	ID          string
+	FieldValues map[string]string
+}
+
+// GeoLocation is a member replied by GEOSEARCH and GEORADIUS. The Dist, GeoHash, Longitude and Latitude are
+// only filled with the WITHDIST, WITHHASH and WITHCOORD options respectively.
+type GeoLocation struct {
+	Name                      string
+	Longitude, Latitude, Dist float64
+	GeoHash                   int64
+}
+
+func (r Result) AsBytes() ([]byte, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsBytes()
+}
+
+func (r Result) AsInt64() (int64, error) {
+	if err := r.Error(); err != nil {
+		return 0, err
+	}
+	return r.val.AsInt64()
+}
+
+func (r Result) AsFloat64() (float64, error) {
+	if err := r.Error(); err != nil {
+		return 0, err
+	}
+	return r.val.AsFloat64()
+}
+
+func (r Result) AsStrSlice() ([]string, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsStrSlice()
+}
+
+func (r Result) AsIntSlice() ([]int64, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsIntSlice()
+}
+
+func (r Result) AsFloatSlice() ([]float64, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsFloatSlice()
+}
+
+func (r Result) AsStrMap() (map[string]string, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsStrMap()
+}
+
+func (r Result) AsIntMap() (map[string]int64, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsIntMap()
+}
+
+func (r Result) AsZScores() ([]ZScore, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsZScores()
+}
+
+func (r Result) AsXRange() ([]XRangeEntry, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsXRange()
+}
+
+func (r Result) AsGeoLocations() ([]GeoLocation, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.AsGeoLocations()
+}
+
+func (r Result) DecodeInto(v interface{}) error {
+	if err := r.Error(); err != nil {
+		return err
+	}
+	return r.val.DecodeInto(v)
+}
+
+// AsBytes returns a copy of the string message in bytes
+func (m *Message) AsBytes() ([]byte, error) {
+	s, err := m.ToString()
+	if err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// AsInt64 converts the integer message or the string message, such as a GET reply, into an int64
+func (m *Message) AsInt64() (int64, error) {
+	if m.Type == ':' {
+		return m.Integer, nil
+	}
+	s, err := m.ToString()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
+
+// AsFloat64 converts the double, integer or string message into a float64
+func (m *Message) AsFloat64() (float64, error) {
+	switch m.Type {
+	case ',':
+		return m.ToFloat64()
+	case ':':
+		return float64(m.Integer), nil
+	}
+	s, err := m.ToString()
+	if err != nil {
+		return 0, err
+	}
+	return strconv.ParseFloat(s, 64)
+}
+
+func (m *Message) AsStrSlice() ([]string, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	s := make([]string, 0, len(values))
+	for _, v := range values {
+		if v.IsNil() {
+			s = append(s, "")
+			continue
+		}
+		str, err := v.ToString()
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, str)
+	}
+	return s, nil
+}
+
+func (m *Message) AsIntSlice() ([]int64, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	s := make([]int64, 0, len(values))
+	for _, v := range values {
+		i, err := v.AsInt64()
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, i)
+	}
+	return s, nil
+}
+
+func (m *Message) AsFloatSlice() ([]float64, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	s := make([]float64, 0, len(values))
+	for _, v := range values {
+		f, err := v.AsFloat64()
+		if err != nil {
+			return nil, err
+		}
+		s = append(s, f)
+	}
+	return s, nil
+}
+
+// pairs returns the flatten key value pairs of the map message or the array message with even length
+func (m *Message) pairs() ([]Message, error) {
+	if m.Type == '%' {
+		return m.Values, nil
+	}
+	values, err := m.ToArray()
+	if err != nil {
+		if pe, ok := err.(*ParseError); ok {
+			pe.Target, pe.Expected = "map", []byte{'%', '*', '~'}
+		}
+		return nil, err
+	}
+	if len(values)%2 != 0 {
+		return nil, fmt.Errorf("redis array message with odd length %d can't be converted into a map", len(values))
+	}
+	return values, nil
+}
+
+func (m *Message) AsStrMap() (map[string]string, error) {
+	values, err := m.pairs()
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]string, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		k, err := values[i].ToString()
+		if err != nil {
+			return nil, err
+		}
+		v, err := values[i+1].ToString()
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+func (m *Message) AsIntMap() (map[string]int64, error) {
+	values, err := m.pairs()
+	if err != nil {
+		return nil, err
+	}
+	r := make(map[string]int64, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		k, err := values[i].ToString()
+		if err != nil {
+			return nil, err
+		}
+		v, err := values[i+1].AsInt64()
+		if err != nil {
+			return nil, err
+		}
+		r[k] = v
+	}
+	return r, nil
+}
+
+// AsZScores converts the reply of sorted set commands WITHSCORES, which is an array of [member, score] pairs in RESP3.
+// The flatten member score array is also supported.
+func (m *Message) AsZScores() ([]ZScore, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	if len(values) > 0 && values[0].Type != '*' {
+		if values, err = m.pairs(); err != nil {
+			return nil, err
+		}
+		s := make([]ZScore, 0, len(values)/2)
+		for i := 0; i < len(values); i += 2 {
+			if s, err = appendZScore(s, values[i], values[i+1]); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	}
+	s := make([]ZScore, 0, len(values))
+	for _, v := range values {
+		pair, err := v.ToArray()
+		if err != nil {
+			return nil, err
+		}
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("redis zscore message should have 2 elements, got %d", len(pair))
+		}
+		if s, err = appendZScore(s, pair[0], pair[1]); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+func appendZScore(s []ZScore, member, score Message) ([]ZScore, error) {
+	m, err := member.ToString()
+	if err != nil {
+		return nil, err
+	}
+	f, err := score.AsFloat64()
+	if err != nil {
+		return nil, err
+	}
+	return append(s, ZScore{Member: m, Score: f}), nil
+}
+
+// AsXRange converts the array of stream entries, such as the XRANGE reply or a stream in the XREAD reply
+func (m *Message) AsXRange() ([]XRangeEntry, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	s := make([]XRangeEntry, 0, len(values))
+	for _, v := range values {
+		entry, err := v.ToArray()
+		if err != nil {
+			return nil, err
+		}
+		if len(entry) != 2 {
+			return nil, fmt.Errorf("redis stream entry message should have 2 elements, got %d", len(entry))
+		}
+		id, err := entry[0].ToString()
+		if err != nil {
+			return nil, err
+		}
+		var fv map[string]string
+		if !entry[1].IsNil() {
+			if fv, err = entry[1].AsStrMap(); err != nil {
+				return nil, err
+			}
+		}
+		s = append(s, XRangeEntry{ID: id, FieldValues: fv})
+	}
+	return s, nil
+}
+
+// AsGeoLocations converts the GEOSEARCH and GEORADIUS reply, with or without the WITHDIST, WITHHASH and WITHCOORD options
+func (m *Message) AsGeoLocations() ([]GeoLocation, error) {
+	values, err := m.ToArray()
+	if err != nil {
+		return nil, err
+	}
+	s := make([]GeoLocation, 0, len(values))
+	for _, v := range values {
+		var loc GeoLocation
+		if v.Type != '*' {
+			if loc.Name, err = v.ToString(); err != nil {
+				return nil, err
+			}
+			s = append(s, loc)
+			continue
+		}
+		if len(v.Values) == 0 {
+			return nil, errors.New("redis geo location message should not be empty")
+		}
+		if loc.Name, err = v.Values[0].ToString(); err != nil {
+			return nil, err
+		}
+		// the order of optional info is always dist, hash and coord
+		for _, info := range v.Values[1:] {
+			switch info.Type {
+			case ':':
+				loc.GeoHash = info.Integer
+			case '*':
+				coord, err := info.AsFloatSlice()
+				if err != nil {
+					return nil, err
+				}
+				if len(coord) != 2 {
+					return nil, fmt.Errorf("redis geo coordinate message should have 2 elements, got %d", len(coord))
+				}
+				loc.Longitude, loc.Latitude = coord[0], coord[1]
+			default:
+				if loc.Dist, err = info.AsFloat64(); err != nil {
+					return nil, err
+				}
+			}
+		}
+		s = append(s, loc)
+	}
+	return s, nil
+}
+
+// DecodeInto decodes the map message, such as the HGETALL reply, into the struct pointed by the v.
+// Only the fields with the `redis:"name"` tag are decoded, and the `redis:"name,sep=|"` is required for string slices,
+// just like the tags used by the om package. Bool fields accept "t", "f" and the values accepted by strconv.ParseBool.
+func (m *Message) DecodeInto(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return ErrDecodeTarget
+	}
+	fields, err := m.AsStrMap()
+	if err != nil {
+		return err
+	}
+	rv = rv.Elem()
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		f := rt.Field(i)
+		tag, ok := f.Tag.Lookup("redis")
+		if !ok || !rv.Field(i).CanSet() {
+			continue
+		}
+		tokens := strings.Split(tag, ",")
+		str, ok := fields[tokens[0]]
+		if !ok || tokens[0] == "-" {
+			continue
+		}
+		var sep string
+		for _, opt := range tokens[1:] {
+			if strings.HasPrefix(opt, "sep=") {
+				sep = strings.TrimPrefix(opt, "sep=")
+			}
+		}
+		if err = decodeField(rv.Field(i), str, sep); err != nil {
+			return fmt.Errorf("failed to decode redis field %q into %s.%s: %w", tokens[0], rt.Name(), f.Name, err)
+		}
+	}
+	return nil
+}
+
+func decodeField(field reflect.Value, str, sep string) (err error) {
+	switch field.Kind() {
+	case reflect.Ptr:
+		v := reflect.New(field.Type().Elem())
+		if err = decodeField(v.Elem(), str, sep); err == nil {
+			field.Set(v)
+		}
+	case reflect.String:
+		field.SetString(str)
+	case reflect.Bool:
+		var b bool
+		switch str {
+		case "t":
+			b = true
+		case "f":
+			b = false
+		default:
+			if b, err = strconv.ParseBool(str); err != nil {
+				return err
+			}
+		}
+		field.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+		if i, err = strconv.ParseInt(str, 10, field.Type().Bits()); err == nil {
+			field.SetInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		var u uint64
+		if u, err = strconv.ParseUint(str, 10, field.Type().Bits()); err == nil {
+			field.SetUint(u)
+		}
+	case reflect.Float32, reflect.Float64:
+		var f float64
+		if f, err = strconv.ParseFloat(str, field.Type().Bits()); err == nil {
+			field.SetFloat(f)
+		}
+	case reflect.Slice:
+		switch {
+		case field.Type().Elem().Kind() == reflect.Uint8:
+			field.SetBytes([]byte(str))
+		case field.Type().Elem().Kind() == reflect.String && sep != "":
+			field.Set(reflect.ValueOf(strings.Split(str, sep)).Convert(field.Type()))
+		default:
+			return fmt.Errorf("unsupported slice type %s or missing sep option", field.Type())
+		}
+	default:
+		return fmt.Errorf("unsupported type %s", field.Type())
+	}
+	return err
+}
@@ -0,0 +1,160 @@
+package proto
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func str(s string) Message {
+	return Message{Type: '$', String: s}
+}
+
+func arr(values ...Message) Message {
+	return Message{Type: '*', Values: values}
+}
+
+func TestAsSlices(t *testing.T) {
+	if v, err := NewResult(arr(str("a"), Message{Type: '_'}, str("b")), nil).AsStrSlice(); err != nil || !reflect.DeepEqual(v, []string{"a", "", "b"}) {
+		t.Fatalf("unexpected AsStrSlice %v %v", v, err)
+	}
+	if v, err := NewResult(arr(Message{Type: ':', Integer: 1}, str("2")), nil).AsIntSlice(); err != nil || !reflect.DeepEqual(v, []int64{1, 2}) {
+		t.Fatalf("unexpected AsIntSlice %v %v", v, err)
+	}
+	if v, err := NewResult(arr(Message{Type: ',', String: "1.5"}, Message{Type: ':', Integer: 2}, str("3.5")), nil).AsFloatSlice(); err != nil || !reflect.DeepEqual(v, []float64{1.5, 2, 3.5}) {
+		t.Fatalf("unexpected AsFloatSlice %v %v", v, err)
+	}
+	if v, err := NewResult(str("bytes"), nil).AsBytes(); err != nil || string(v) != "bytes" {
+		t.Fatalf("unexpected AsBytes %v %v", v, err)
+	}
+	if _, err := NewResult(arr(str("a")), nil).AsIntSlice(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	if _, err := NewResult(str("a"), nil).AsStrSlice(); !errors.Is(err, ErrParse) {
+		t.Fatalf("unexpected err %v", err)
+	}
+	e := errors.New("e")
+	if _, err := NewErrResult(e).AsStrSlice(); err != e {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestAsMaps(t *testing.T) {
+	m := Message{Type: '%', Values: []Message{str("a"), str("1"), str("b"), {Type: ':', Integer: 2}}}
+	if v, err := NewResult(m, nil).AsIntMap(); err != nil || !reflect.DeepEqual(v, map[string]int64{"a": 1, "b": 2}) {
+		t.Fatalf("unexpected AsIntMap %v %v", v, err)
+	}
+	if v, err := NewResult(arr(str("a"), str("1")), nil).AsStrMap(); err != nil || !reflect.DeepEqual(v, map[string]string{"a": "1"}) {
+		t.Fatalf("unexpected AsStrMap %v %v", v, err)
+	}
+	if _, err := NewResult(arr(str("a")), nil).AsStrMap(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	var pe *ParseError
+	if _, err := NewResult(str("a"), nil).AsStrMap(); !errors.As(err, &pe) || pe.Target != "map" {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestAsZScores(t *testing.T) {
+	expected := []ZScore{{Member: "a", Score: 1}, {Member: "b", Score: 2.5}}
+	resp3 := arr(arr(str("a"), Message{Type: ',', String: "1"}), arr(str("b"), Message{Type: ',', String: "2.5"}))
+	if v, err := NewResult(resp3, nil).AsZScores(); err != nil || !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected AsZScores %v %v", v, err)
+	}
+	flatten := arr(str("a"), str("1"), str("b"), str("2.5"))
+	if v, err := NewResult(flatten, nil).AsZScores(); err != nil || !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected AsZScores %v %v", v, err)
+	}
+	if _, err := NewResult(arr(arr(str("a"))), nil).AsZScores(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+}
+
+func TestAsXRange(t *testing.T) {
+	m := arr(
+		arr(str("1-0"), arr(str("f"), str("v"))),
+		arr(str("2-0"), Message{Type: '_'}),
+	)
+	expected := []XRangeEntry{{ID: "1-0", FieldValues: map[string]string{"f": "v"}}, {ID: "2-0"}}
+	if v, err := NewResult(m, nil).AsXRange(); err != nil || !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected AsXRange %v %v", v, err)
+	}
+	if _, err := NewResult(arr(arr(str("1-0"))), nil).AsXRange(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+}
+
+func TestAsGeoLocations(t *testing.T) {
+	m := arr(
+		str("a"),
+		arr(str("b"), str("1.5"), Message{Type: ':', Integer: 123}, arr(str("13.3"), str("38.1"))),
+		arr(str("c"), arr(str("1"), str("2"))),
+	)
+	expected := []GeoLocation{
+		{Name: "a"},
+		{Name: "b", Dist: 1.5, GeoHash: 123, Longitude: 13.3, Latitude: 38.1},
+		{Name: "c", Longitude: 1, Latitude: 2},
+	}
+	if v, err := NewResult(m, nil).AsGeoLocations(); err != nil || !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected AsGeoLocations %v %v", v, err)
+	}
+	if _, err := NewResult(arr(arr()), nil).AsGeoLocations(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+}
+
+func TestDecodeInto(t *testing.T) {
+	type Named []string
+	type Target struct {
+		Str     string  `redis:"str"`
+		Int     int32   `redis:"int"`
+		Uint    uint8   `redis:"uint"`
+		Float   float64 `redis:"float"`
+		Bool    bool    `redis:"bool"`
+		Bool2   bool    `redis:"bool2"`
+		Bytes   []byte  `redis:"bytes"`
+		Slice   Named   `redis:"slice,sep=|"`
+		Ptr     *int64  `redis:"ptr"`
+		Missing string  `redis:"missing"`
+		Ignored string  `redis:"-"`
+		NoTag   string
+		private string `redis:"private"`
+	}
+	m := Message{Type: '%', Values: []Message{
+		str("str"), str("s"),
+		str("int"), str("-1"),
+		str("uint"), str("2"),
+		str("float"), str("1.5"),
+		str("bool"), str("t"),
+		str("bool2"), str("true"),
+		str("bytes"), str("b"),
+		str("slice"), str("a|b"),
+		str("ptr"), str("3"),
+		str("-"), str("x"),
+		str("NoTag"), str("x"),
+		str("private"), str("x"),
+	}}
+	var v Target
+	if err := NewResult(m, nil).DecodeInto(&v); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	three := int64(3)
+	expected := Target{Str: "s", Int: -1, Uint: 2, Float: 1.5, Bool: true, Bool2: true, Bytes: []byte("b"), Slice: Named{"a", "b"}, Ptr: &three}
+	if !reflect.DeepEqual(v, expected) {
+		t.Fatalf("unexpected decoded %v", v)
+	}
+	if err := m.DecodeInto(v); err != ErrDecodeTarget {
+		t.Fatalf("unexpected err %v", err)
+	}
+	bad := Message{Type: '%', Values: []Message{str("int"), str("a")}}
+	if err := bad.DecodeInto(&v); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	unsupported := struct {
+		M map[string]string `redis:"m"`
+	}{}
+	if err := (&Message{Type: '%', Values: []Message{str("m"), str("a")}}).DecodeInto(&unsupported); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+}
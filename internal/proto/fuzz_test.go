@@ -0,0 +1,38 @@
+//go:build go1.18
+
+package proto
+
+import (
+	"bufio"
+	"bytes"
+	"testing"
+)
+
+func FuzzReadNextMessage(f *testing.F) {
+	for _, seed := range []string{
+		"+OK\r\n",
+		"-ERR bad\r\n",
+		":-123\r\n",
+		"$5\r\nhello\r\n",
+		"$?\r\n;2\r\nhe\r\n;3\r\nllo\r\n;0\r\n",
+		"*2\r\n:1\r\n$1\r\na\r\n",
+		"%1\r\n+k\r\n#t\r\n",
+		"|1\r\n+ttl\r\n:3\r\n+v\r\n",
+		"*?\r\n:1\r\n.\r\n",
+		">3\r\n+message\r\n+ch\r\n+v\r\n",
+		",1.5\r\n",
+		"(12345678901234567890\r\n",
+		"=15\r\ntxt:Some string\r\n",
+		"_\r\n",
+	} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := bufio.NewReader(bytes.NewReader(data))
+		for {
+			if _, err := ReadNextMessage(r); err != nil {
+				return
+			}
+		}
+	})
+}
@@ -2,8 +2,10 @@ package proto
 
 import (
 	"bufio"
+	"bytes"
 	"errors"
 	"io"
+	"math"
 	"strconv"
 	"strings"
 	"unsafe"
@@ -11,6 +13,27 @@ import (
 
 var chunked = errors.New("unbounded redis message")
 
+const (
+	// MaxBlobLength is the max declared length of a blob string, which is the same as the default proto-max-bulk-len of redis.
+	MaxBlobLength = 512 * (1 << 20)
+	// MaxAggregateLength is the max declared number of elements of an aggregate message.
+	MaxAggregateLength = 1 << 32
+	// preallocated and preallocatedBlob are the max number of elements and bytes allocated before they are actually read,
+	// so that a corrupted length can't cause a huge allocation.
+	preallocated     = 1 << 10
+	preallocatedBlob = 1 << 16
+)
+
+// ProtocolError is returned when a malformed message is received.
+// The connection which received the message is broken and should not be used anymore.
+type ProtocolError struct {
+	Reason string
+}
+
+func (e *ProtocolError) Error() string {
+	return "redis protocol error: " + e.Reason
+}
+
 type reader func(i *bufio.Reader) (Message, error)
 
 var readers = [256]reader{}
@@ -44,10 +67,12 @@ func readBlobString(i *bufio.Reader) (m Message, err error) {
 	if err == chunked {
 		sb := strings.Builder{}
 		for {
-			if _, err = i.Discard(1); err != nil { // discard the ';'
+			if b, err := i.ReadByte(); err != nil {
 				return Message{}, err
+			} else if b != ';' {
+				return Message{}, &ProtocolError{Reason: "unexpected streamed string chunk byte " + strconv.Quote(string(b))}
 			}
-			length, err := ReadI(i)
+			length, err := readLength(i, MaxBlobLength-int64(sb.Len()))
 			if err != nil {
 				return Message{}, err
 			}
@@ -58,7 +83,7 @@ func readBlobString(i *bufio.Reader) (m Message, err error) {
 			if _, err = io.CopyN(&sb, i, length); err != nil {
 				return Message{}, err
 			}
-			if _, err = i.Discard(2); err != nil {
+			if err = readCRLF(i); err != nil {
 				return Message{}, err
 			}
 		}
@@ -76,49 +101,82 @@ func readBoolean(i *bufio.Reader) (m Message, err error) {
 	if err != nil {
 		return Message{}, err
 	}
-	if b == 't' {
+	switch b {
+	case 't':
 		m.Integer = 1
+	case 'f':
+	default:
+		return Message{}, &ProtocolError{Reason: "unexpected boolean byte " + strconv.Quote(string(b))}
 	}
-	_, err = i.Discard(2)
+	err = readCRLF(i)
 	return
 }
 
 func readNull(i *bufio.Reader) (m Message, err error) {
-	_, err = i.Discard(2)
+	err = readCRLF(i)
 	return
 }
 
 func readArray(i *bufio.Reader) (m Message, err error) {
-	length, err := ReadI(i)
+	length, err := readLength(i, MaxAggregateLength)
 	if err == chunked {
 		m.Values, err = readE(i)
+		return m, err
 	}
 	if err != nil {
 		return Message{}, err
 	}
-	m.Values, err = readA(i, int(length))
+	m.Values, err = readA(i, length)
 	return
 }
 
 func readMap(i *bufio.Reader) (m Message, err error) {
-	length, err := ReadI(i)
+	length, err := readLength(i, MaxAggregateLength)
 	if err == chunked {
 		m.Values, err = readE(i)
+		if err == nil && len(m.Values)%2 != 0 {
+			err = &ProtocolError{Reason: "streamed map with odd number of elements"}
+		}
+		return m, err
 	}
 	if err != nil {
 		return Message{}, err
 	}
-	m.Values, err = readA(i, int(length*2))
+	m.Values, err = readA(i, length*2)
 	return
 }
 
+func readCRLF(i *bufio.Reader) error {
+	bs, err := i.Peek(2)
+	if err != nil {
+		return err
+	}
+	if bs[0] != '\r' || bs[1] != '\n' {
+		return &ProtocolError{Reason: "expect CRLF but got " + strconv.Quote(string(bs))}
+	}
+	_, err = i.Discard(2)
+	return err
+}
+
+// readLength reads a non-negative length which is not greater than the max
+func readLength(i *bufio.Reader, max int64) (int64, error) {
+	length, err := ReadI(i)
+	if err != nil {
+		return 0, err
+	}
+	if length < 0 || length > max {
+		return 0, &ProtocolError{Reason: "invalid length " + strconv.FormatInt(length, 10)}
+	}
+	return length, nil
+}
+
 func ReadS(i *bufio.Reader) (string, error) {
 	bs, err := i.ReadBytes('\n')
 	if err != nil {
 		return "", err
 	}
-	if trim := len(bs) - 2; trim < 0 {
-		panic("received unexpected simple string message ending without CRLF")
+	if trim := len(bs) - 2; trim < 0 || bs[trim] != '\r' {
+		return "", &ProtocolError{Reason: "simple string message ending without CRLF"}
 	} else {
 		bs = bs[:trim]
 	}
@@ -128,40 +186,62 @@ func ReadS(i *bufio.Reader) (string, error) {
 func ReadI(i *bufio.Reader) (int64, error) {
 	var v int64
 	var neg bool
-	for {
+	for n := 0; ; n++ {
 		c, err := i.ReadByte()
 		if err != nil {
 			return 0, err
 		}
 		switch {
 		case '0' <= c && c <= '9':
+			if v > (math.MaxInt64-int64(c-'0'))/10 {
+				return 0, &ProtocolError{Reason: "number overflows int64"}
+			}
 			v = v*10 + int64(c-'0')
 		case '\r' == c:
-			_, err = i.Discard(1)
+			if c, err = i.ReadByte(); err != nil {
+				return 0, err
+			} else if c != '\n' {
+				return 0, &ProtocolError{Reason: "number ending without CRLF"}
+			}
 			if neg {
-				return v * -1, err
+				return v * -1, nil
 			}
-			return v, err
-		case '-' == c:
+			return v, nil
+		case '-' == c && n == 0:
 			neg = true
-		case '?' == c:
+		case '?' == c && n == 0:
+			if err = readCRLF(i); err != nil {
+				return 0, err
+			}
 			return 0, chunked
 		default:
-			panic("received unexpected number byte: " + string(c))
+			return 0, &ProtocolError{Reason: "unexpected number byte " + strconv.Quote(string(c))}
 		}
 	}
 }
 
 func ReadB(i *bufio.Reader) (string, error) {
-	length, err := ReadI(i)
+	length, err := readLength(i, MaxBlobLength)
 	if err != nil {
 		return "", err
 	}
-	bs := make([]byte, length)
-	if _, err = io.ReadFull(i, bs); err != nil {
-		return "", err
+	var bs []byte
+	if length <= preallocatedBlob {
+		bs = make([]byte, length)
+		if _, err = io.ReadFull(i, bs); err != nil {
+			return "", err
+		}
+	} else { // grow the buffer along with the data actually received
+		buf := bytes.NewBuffer(make([]byte, 0, preallocatedBlob))
+		if _, err = io.CopyN(buf, i, length); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return "", err
+		}
+		bs = buf.Bytes()
 	}
-	if _, err = i.Discard(2); err != nil {
+	if err = readCRLF(i); err != nil {
 		return "", err
 	}
 	return *(*string)(unsafe.Pointer(&bs)), nil
@@ -181,16 +261,63 @@ func readE(i *bufio.Reader) ([]Message, error) {
 	}
 }
 
-func readA(i *bufio.Reader, length int) (v []Message, err error) {
-	v = make([]Message, length)
-	for n := 0; n < length; n++ {
-		if v[n], err = ReadNextMessage(i); err != nil {
+func readA(i *bufio.Reader, length int64) (v []Message, err error) {
+	if length > preallocated {
+		v = make([]Message, 0, preallocated)
+	} else {
+		v = make([]Message, 0, length)
+	}
+	for n := int64(0); n < length; n++ {
+		m, err := ReadNextMessage(i)
+		if err != nil {
 			return nil, err
 		}
+		v = append(v, m)
 	}
 	return v, nil
 }
 
+// StreamNextMessage copies the next message to the w if it is a blob string, without buffering the whole string.
+// Otherwise, the message is read as usual and returned. Unlike the ReadNextMessage, the MaxBlobLength is not applied.
+func StreamNextMessage(i *bufio.Reader, w io.Writer) (n int64, m Message, err error) {
+	b, err := i.Peek(1)
+	if err != nil {
+		return 0, Message{}, err
+	}
+	if b[0] != '$' {
+		m, err = ReadNextMessage(i)
+		return 0, m, err
+	}
+	_, _ = i.Discard(1)
+	length, err := readLength(i, math.MaxInt64)
+	if err != chunked {
+		if err != nil {
+			return 0, Message{}, err
+		}
+		if n, err = io.CopyN(w, i, length); err == nil {
+			err = readCRLF(i)
+		}
+		return n, Message{Type: '$'}, err
+	}
+	for {
+		if b, err := i.ReadByte(); err != nil {
+			return n, Message{}, err
+		} else if b != ';' {
+			return n, Message{}, &ProtocolError{Reason: "unexpected streamed string chunk byte " + strconv.Quote(string(b))}
+		}
+		if length, err = readLength(i, math.MaxInt64); err != nil || length == 0 {
+			return n, Message{Type: '$'}, err
+		}
+		written, err := io.CopyN(w, i, length)
+		if n += written; err != nil {
+			return n, Message{}, err
+		}
+		if err = readCRLF(i); err != nil {
+			return n, Message{}, err
+		}
+	}
+}
+
 func WriteB(o *bufio.Writer, id byte, str string) (err error) {
 	_ = WriteS(o, id, strconv.Itoa(len(str)))
 	_, _ = o.WriteString(str)
@@ -81,3 +81,28 @@ func randN(n int) (v int) {
 	}
 	return
 }
+
+func TestStreamNextMessage(t *testing.T) {
+	for _, c := range []struct {
+		in  string
+		out string
+		typ byte
+	}{
+		{in: "$5\r\nhello\r\n", out: "hello", typ: '$'},
+		{in: "$0\r\n\r\n", out: "", typ: '$'},
+		{in: "$?\r\n;2\r\nhe\r\n;3\r\nllo\r\n;0\r\n", out: "hello", typ: '$'},
+		{in: ":1\r\n", out: "", typ: ':'},
+		{in: "_\r\n", out: "", typ: '_'},
+	} {
+		buf := bytes.NewBuffer(nil)
+		n, m, err := StreamNextMessage(bufio.NewReader(strings.NewReader(c.in)), buf)
+		if err != nil || n != int64(len(c.out)) || buf.String() != c.out || m.Type != c.typ {
+			t.Fatalf("unexpected result %v %v %v %q for %q", n, m, err, buf.String(), c.in)
+		}
+	}
+	for _, in := range []string{"$5\r\nhelloXX", "$-1\r\n", "$?\r\n:1\r\n"} {
+		if _, _, err := StreamNextMessage(bufio.NewReader(strings.NewReader(in)), bytes.NewBuffer(nil)); err == nil {
+			t.Fatalf("unexpected nil err for %q", in)
+		}
+	}
+}
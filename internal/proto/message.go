@@ -2,7 +2,12 @@ package proto
 
 import (
 	"bufio"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/big"
+	"net"
 	"strconv"
 	"strings"
 	"unsafe"
@@ -48,10 +53,47 @@ func (r *RedisError) IsTryAgain() bool {
 	return strings.HasPrefix(r.String, "TRYAGAIN")
 }
 
+func (r *RedisError) IsClusterDown() bool {
+	return strings.HasPrefix(r.String, "CLUSTERDOWN")
+}
+
+func (r *RedisError) IsLoading() bool {
+	return strings.HasPrefix(r.String, "LOADING")
+}
+
+func (r *RedisError) IsMasterDown() bool {
+	return strings.HasPrefix(r.String, "MASTERDOWN")
+}
+
+func (r *RedisError) IsReadOnly() bool {
+	return strings.HasPrefix(r.String, "READONLY")
+}
+
 func (r *RedisError) IsNoScript() bool {
 	return strings.HasPrefix(r.String, "NOSCRIPT")
 }
 
+// ErrParse matches any *ParseError with errors.Is
+var ErrParse = errors.New("redis message parse error")
+
+// ParseError is returned when a message is converted into an unexpected type.
+type ParseError struct {
+	// Target is the name of the go type to be converted into.
+	Target string
+	// Expected is the RESP3 types that can be converted into the Target.
+	Expected []byte
+	// Actual is the RESP3 type of the message.
+	Actual byte
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("redis message type %c is not a %s, expected %c", e.Actual, e.Target, e.Expected)
+}
+
+func (e *ParseError) Is(target error) bool {
+	return target == ErrParse
+}
+
 func NewResult(val Message, err error) Result {
 	return Result{val: val, err: err}
 }
@@ -90,6 +132,13 @@ func (r Result) Value() (Message, error) {
 	return r.val, r.Error()
 }
 
+func (r Result) ToBytes() ([]byte, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.ToBytes()
+}
+
 func (r Result) ToInt64() (int64, error) {
 	if err := r.Error(); err != nil {
 		return 0, err
@@ -118,6 +167,20 @@ func (r Result) ToString() (string, error) {
 	return r.val.ToString()
 }
 
+func (r Result) ToBigInt() (*big.Int, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.ToBigInt()
+}
+
+func (r Result) Verbatim() (format, text string, err error) {
+	if err = r.Error(); err != nil {
+		return "", "", err
+	}
+	return r.val.Verbatim()
+}
+
 func (r Result) ToArray() ([]Message, error) {
 	if err := r.Error(); err != nil {
 		return nil, err
@@ -132,6 +195,15 @@ func (r Result) ToMap() (map[string]Message, error) {
 	return r.val.ToMap()
 }
 
+// Attributes returns the RESP3 attributes sent along with the reply, such as the key popularity hints.
+// It returns nil if there is no attribute.
+func (r Result) Attributes() (map[string]Message, error) {
+	if err := r.Error(); err != nil {
+		return nil, err
+	}
+	return r.val.Attributes()
+}
+
 type Message struct {
 	String  string
 	Integer int64
@@ -151,24 +223,82 @@ func (m *Message) Error() error {
 	return nil
 }
 
+// ToString converts the string message into a go string. The format prefix of the verbatim string is removed.
 func (m *Message) ToString() (val string, err error) {
 	if m.Type == '$' || m.Type == '+' {
 		return m.String, nil
 	}
+	if m.Type == '=' {
+		_, val, err = m.Verbatim()
+		return
+	}
 	if m.Type == ':' || m.Values != nil {
-		panic(fmt.Sprintf("redis message type %c is not a string", m.Type))
+		return "", &ParseError{Target: "string", Expected: []byte{'$', '+'}, Actual: m.Type}
 	}
 	return m.String, m.Error()
 }
 
+// ToBytes returns the string message in bytes without copying, therefore the returned bytes must not be modified.
+// Use the AsBytes instead if a copy is needed.
+func (m *Message) ToBytes() ([]byte, error) {
+	s, err := m.ToString()
+	if err != nil {
+		return nil, err
+	}
+	return sbytes(s), nil
+}
+
+// sbytes converts the s into bytes without copying, the returned bytes must not be modified.
+func sbytes(s string) []byte {
+	return *(*[]byte)(unsafe.Pointer(&struct {
+		string
+		int
+	}{s, len(s)}))
+}
+
+// ToInt64 converts the integer message or the big number message which fits into an int64.
 func (m *Message) ToInt64() (val int64, err error) {
 	if m.Type == ':' {
 		return m.Integer, nil
 	}
+	if m.Type == '(' {
+		return strconv.ParseInt(m.String, 10, 64)
+	}
 	if err = m.Error(); err != nil {
 		return 0, err
 	}
-	panic(fmt.Sprintf("redis message type %c is not a int64", m.Type))
+	return 0, &ParseError{Target: "int64", Expected: []byte{':', '('}, Actual: m.Type}
+}
+
+// ToBigInt converts the big number message or the integer message into a *big.Int
+func (m *Message) ToBigInt() (*big.Int, error) {
+	switch m.Type {
+	case '(':
+		if v, ok := new(big.Int).SetString(m.String, 10); ok {
+			return v, nil
+		}
+		return nil, fmt.Errorf("redis message %q is not a valid big number", m.String)
+	case ':':
+		return big.NewInt(m.Integer), nil
+	}
+	if err := m.Error(); err != nil {
+		return nil, err
+	}
+	return nil, &ParseError{Target: "big.Int", Expected: []byte{'(', ':'}, Actual: m.Type}
+}
+
+// Verbatim returns the format, such as txt or mkd, and the text of the verbatim string message
+func (m *Message) Verbatim() (format, text string, err error) {
+	if m.Type == '=' {
+		if len(m.String) < 4 || m.String[3] != ':' {
+			return "", "", fmt.Errorf("redis verbatim string %q has no format prefix", m.String)
+		}
+		return m.String[:3], m.String[4:], nil
+	}
+	if err = m.Error(); err != nil {
+		return "", "", err
+	}
+	return "", "", &ParseError{Target: "verbatim string", Expected: []byte{'='}, Actual: m.Type}
 }
 
 func (m *Message) ToBool() (val bool, err error) {
@@ -178,17 +308,26 @@ func (m *Message) ToBool() (val bool, err error) {
 	if err = m.Error(); err != nil {
 		return false, err
 	}
-	panic(fmt.Sprintf("redis message type %c is not a bool", m.Type))
+	return false, &ParseError{Target: "bool", Expected: []byte{'#'}, Actual: m.Type}
 }
 
+// ToFloat64 converts the double message into a float64, including the inf, -inf and nan.
 func (m *Message) ToFloat64() (val float64, err error) {
 	if m.Type == ',' {
+		switch m.String {
+		case "inf":
+			return math.Inf(1), nil
+		case "-inf":
+			return math.Inf(-1), nil
+		case "nan":
+			return math.NaN(), nil
+		}
 		return strconv.ParseFloat(m.String, 64)
 	}
 	if err = m.Error(); err != nil {
 		return 0, err
 	}
-	panic(fmt.Sprintf("redis message type %c is not a float64", m.Type))
+	return 0, &ParseError{Target: "float64", Expected: []byte{','}, Actual: m.Type}
 }
 
 func (m *Message) ToArray() ([]Message, error) {
@@ -198,25 +337,48 @@ func (m *Message) ToArray() ([]Message, error) {
 	if err := m.Error(); err != nil {
 		return nil, err
 	}
-	panic(fmt.Sprintf("redis message type %c is not a array", m.Type))
+	return nil, &ParseError{Target: "array", Expected: []byte{'*', '~'}, Actual: m.Type}
 }
 
+// ToMap converts the map message into a go map. Integer and double keys are converted to strings.
 func (m *Message) ToMap() (map[string]Message, error) {
 	if m.Type == '%' {
-		r := make(map[string]Message, len(m.Values)/2)
-		for i := 0; i < len(m.Values); i += 2 {
-			if m.Values[i].Type == '$' || m.Values[i].Type == '+' {
-				r[m.Values[i].String] = m.Values[i+1]
-				continue
-			}
-			panic(fmt.Sprintf("redis message type %c as map key is not supported by ToMap", m.Values[i].Type))
-		}
-		return r, nil
+		return toMap(m.Values)
 	}
 	if err := m.Error(); err != nil {
 		return nil, err
 	}
-	panic(fmt.Sprintf("redis message type %c is not a map", m.Type))
+	return nil, &ParseError{Target: "map", Expected: []byte{'%'}, Actual: m.Type}
+}
+
+// Attributes converts the attributes of the message into a go map in the same way as ToMap.
+// It returns nil if there is no attribute.
+func (m *Message) Attributes() (map[string]Message, error) {
+	if m.Attrs == nil {
+		return nil, nil
+	}
+	return toMap(m.Attrs.Values)
+}
+
+func toMap(values []Message) (map[string]Message, error) {
+	r := make(map[string]Message, len(values)/2)
+	for i := 0; i < len(values); i += 2 {
+		switch k := values[i]; k.Type {
+		case '$', '+', ',', '(':
+			r[k.String] = values[i+1]
+		case '=':
+			_, text, err := k.Verbatim()
+			if err != nil {
+				return nil, err
+			}
+			r[text] = values[i+1]
+		case ':':
+			r[strconv.FormatInt(k.Integer, 10)] = values[i+1]
+		default:
+			return nil, &ParseError{Target: "map key", Expected: []byte{'$', '+', ':', ','}, Actual: k.Type}
+		}
+	}
+	return r, nil
 }
 
 func (m *Message) ApproximateSize() (s int) {
@@ -240,7 +402,7 @@ func ReadNextMessage(i *bufio.Reader) (m Message, err error) {
 		}
 		fn := readers[typ]
 		if fn == nil {
-			panic("received unknown message type: " + string(typ))
+			return Message{}, &ProtocolError{Reason: "unknown message type " + strconv.Quote(string(typ))}
 		}
 		if m, err = fn(i); err != nil {
 			return Message{}, err
@@ -264,3 +426,29 @@ func WriteCmd(o *bufio.Writer, cmd []string) (err error) {
 	}
 	return err
 }
+
+// DirectWriteLength is the min length of an arg to be written directly by the WriteCmdTo
+const DirectWriteLength = 1 << 14
+
+var crlf = []byte("\r\n")
+
+// WriteCmdTo writes the cmd like the WriteCmd, except that the args not shorter than the DirectWriteLength are not
+// copied into the o. Instead, the o is flushed and they are written to the w, the underlying writer of the o,
+// with the net.Buffers, which uses writev if supported.
+func WriteCmdTo(o *bufio.Writer, w io.Writer, cmd []string) (err error) {
+	err = WriteS(o, '*', strconv.Itoa(len(cmd)))
+	for _, m := range cmd {
+		if len(m) < DirectWriteLength {
+			err = WriteB(o, '$', m)
+			continue
+		}
+		if err = o.Flush(); err != nil {
+			return err
+		}
+		bufs := net.Buffers{[]byte("$" + strconv.Itoa(len(m)) + "\r\n"), sbytes(m), crlf}
+		if _, err = bufs.WriteTo(w); err != nil {
+			return err
+		}
+	}
+	return err
+}
@@ -3,6 +3,9 @@ package proto
 import (
 	"bufio"
 	"bytes"
+	"errors"
+	"io"
+	"math"
 	"math/rand"
 	"strconv"
 	"strings"
@@ -142,3 +145,228 @@ func TestWriteCmdAndRead(t *testing.T) {
 		}
 	}
 }
+
+func TestParseError(t *testing.T) {
+	for _, c := range []struct {
+		fn     func(m Message) error
+		target string
+		msg    Message
+	}{
+		{fn: func(m Message) error { _, err := m.ToString(); return err }, target: "string", msg: Message{Type: ':'}},
+		{fn: func(m Message) error { _, err := m.ToString(); return err }, target: "string", msg: Message{Type: '*', Values: []Message{}}},
+		{fn: func(m Message) error { _, err := m.ToInt64(); return err }, target: "int64", msg: Message{Type: '+'}},
+		{fn: func(m Message) error { _, err := m.ToBool(); return err }, target: "bool", msg: Message{Type: '+'}},
+		{fn: func(m Message) error { _, err := m.ToFloat64(); return err }, target: "float64", msg: Message{Type: '+'}},
+		{fn: func(m Message) error { _, err := m.ToArray(); return err }, target: "array", msg: Message{Type: '+'}},
+		{fn: func(m Message) error { _, err := m.ToMap(); return err }, target: "map", msg: Message{Type: '+'}},
+		{fn: func(m Message) error { _, err := m.ToMap(); return err }, target: "map key", msg: Message{Type: '%', Values: []Message{{Type: '*'}, {Type: '+'}}}},
+	} {
+		err := c.fn(c.msg)
+		var pe *ParseError
+		if !errors.As(err, &pe) || !errors.Is(err, ErrParse) {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if pe.Target != c.target || pe.Actual != c.msg.Type && !(c.target == "map key" && pe.Actual == '*') {
+			t.Fatalf("unexpected parse error %v", pe)
+		}
+		if !strings.Contains(pe.Error(), c.target) {
+			t.Fatalf("unexpected error message %v", pe.Error())
+		}
+	}
+}
+
+func TestToMapNonStringKeys(t *testing.T) {
+	m := Message{Type: '%', Values: []Message{
+		{Type: ':', Integer: 1}, {Type: '+', String: "a"},
+		{Type: ',', String: "1.5"}, {Type: '+', String: "b"},
+		{Type: '$', String: "c"}, {Type: '+', String: "c"},
+	}}
+	r, err := m.ToMap()
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if r["1"].String != "a" || r["1.5"].String != "b" || r["c"].String != "c" {
+		t.Fatalf("unexpected map %v", r)
+	}
+}
+
+func TestReadStreamed(t *testing.T) {
+	for _, c := range []struct {
+		in     string
+		typ    byte
+		str    string
+		values int
+	}{
+		{in: "$?\r\n;4\r\nHell\r\n;5\r\no wor\r\n;1\r\nd\r\n;0\r\n", typ: '$', str: "Hello word"},
+		{in: "*?\r\n:1\r\n:2\r\n.\r\n", typ: '*', values: 2},
+		{in: "~?\r\n+a\r\n.\r\n", typ: '~', values: 1},
+		{in: "%?\r\n+a\r\n:1\r\n+b\r\n:2\r\n.\r\n", typ: '%', values: 4},
+	} {
+		m, err := ReadNextMessage(bufio.NewReader(strings.NewReader(c.in)))
+		if err != nil {
+			t.Fatalf("unexpected err %v for %q", err, c.in)
+		}
+		if m.Type != c.typ || m.String != c.str || len(m.Values) != c.values {
+			t.Fatalf("unexpected message %v for %q", m, c.in)
+		}
+	}
+}
+
+func TestReadMalformed(t *testing.T) {
+	for _, in := range []string{
+		"@\r\n",
+		"+OK\n",
+		":12a\r\n",
+		":12\r\r",
+		":99999999999999999999\r\n",
+		"#x\r\n",
+		"#t\n\n",
+		"_x\r\n",
+		"$-5\r\n",
+		"$3\r\nabcd\r\n",
+		"$536870913\r\n",
+		"$?\r\n:4\r\n",
+		"$?\r\n;-1\r\n",
+		"*-2\r\n",
+		"*4294967297\r\n",
+		"%?\r\n+a\r\n.\r\n",
+		"*1\r\n@\r\n",
+	} {
+		_, err := ReadNextMessage(bufio.NewReader(strings.NewReader(in)))
+		var pe *ProtocolError
+		if !errors.As(err, &pe) {
+			t.Fatalf("unexpected err %v for %q", err, in)
+		}
+	}
+	for _, in := range []string{
+		"+OK",
+		"$5\r\nab",
+		"*1000000\r\n:1\r\n",
+	} {
+		if _, err := ReadNextMessage(bufio.NewReader(strings.NewReader(in))); err != io.EOF && err != io.ErrUnexpectedEOF {
+			t.Fatalf("unexpected err %v for %q", err, in)
+		}
+	}
+}
+
+func TestVerbatim(t *testing.T) {
+	m, err := ReadNextMessage(bufio.NewReader(strings.NewReader("=15\r\ntxt:Some string\r\n")))
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if format, text, err := m.Verbatim(); err != nil || format != "txt" || text != "Some string" {
+		t.Fatalf("unexpected verbatim %v %v %v", format, text, err)
+	}
+	if s, err := m.ToString(); err != nil || s != "Some string" {
+		t.Fatalf("unexpected string %v %v", s, err)
+	}
+	if _, _, err := (&Message{Type: '=', String: "txt"}).Verbatim(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	if _, _, err := (&Message{Type: '+', String: "txt:a"}).Verbatim(); !errors.Is(err, ErrParse) {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestToBigInt(t *testing.T) {
+	m, err := ReadNextMessage(bufio.NewReader(strings.NewReader("(3492890328409238509324850943850943825024385\r\n")))
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if v, err := m.ToBigInt(); err != nil || v.String() != "3492890328409238509324850943850943825024385" {
+		t.Fatalf("unexpected big int %v %v", v, err)
+	}
+	if _, err := m.ToInt64(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	if v, err := (&Message{Type: '(', String: "-12"}).ToInt64(); err != nil || v != -12 {
+		t.Fatalf("unexpected int %v %v", v, err)
+	}
+	if v, err := (&Message{Type: ':', Integer: 5}).ToBigInt(); err != nil || v.Int64() != 5 {
+		t.Fatalf("unexpected big int %v %v", v, err)
+	}
+	if _, err := (&Message{Type: '(', String: "abc"}).ToBigInt(); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	if _, err := (&Message{Type: '+', String: "1"}).ToBigInt(); !errors.Is(err, ErrParse) {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestToFloat64Special(t *testing.T) {
+	for _, c := range []struct {
+		in    string
+		check func(float64) bool
+	}{
+		{in: ",inf\r\n", check: func(f float64) bool { return math.IsInf(f, 1) }},
+		{in: ",-inf\r\n", check: func(f float64) bool { return math.IsInf(f, -1) }},
+		{in: ",nan\r\n", check: math.IsNaN},
+		{in: ",1.23e-4\r\n", check: func(f float64) bool { return f == 1.23e-4 }},
+	} {
+		m, err := ReadNextMessage(bufio.NewReader(strings.NewReader(c.in)))
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if f, err := m.ToFloat64(); err != nil || !c.check(f) {
+			t.Fatalf("unexpected float %v %v for %q", f, err, c.in)
+		}
+	}
+}
+
+func TestAttributes(t *testing.T) {
+	m, err := ReadNextMessage(bufio.NewReader(strings.NewReader("|1\r\n+key-popularity\r\n%2\r\n$1\r\na\r\n,0.1923\r\n$1\r\nb\r\n,0.0012\r\n*2\r\n:2039123\r\n:9543892\r\n")))
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	attrs, err := NewResult(m, nil).Attributes()
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	p := attrs["key-popularity"]
+	popularity, err := p.ToMap()
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	a := popularity["a"]
+	if f, _ := a.ToFloat64(); f != 0.1923 {
+		t.Fatalf("unexpected popularity %v", popularity)
+	}
+	if attrs, err := NewResult(Message{Type: '+'}, nil).Attributes(); attrs != nil || err != nil {
+		t.Fatalf("unexpected attributes %v %v", attrs, err)
+	}
+}
+
+func TestWriteCmdTo(t *testing.T) {
+	cmd := []string{"SET", "a", strings.Repeat("a", DirectWriteLength), "b", strings.Repeat("b", DirectWriteLength*2)}
+
+	expected := bytes.NewBuffer(nil)
+	o := bufio.NewWriter(expected)
+	if err := WriteCmd(o, cmd); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	_ = o.Flush()
+
+	actual := bytes.NewBuffer(nil)
+	o = bufio.NewWriter(actual)
+	if err := WriteCmdTo(o, actual, cmd); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	_ = o.Flush()
+
+	if !bytes.Equal(expected.Bytes(), actual.Bytes()) {
+		t.Fatalf("unexpected written bytes")
+	}
+}
+
+func TestToBytes(t *testing.T) {
+	m := Message{Type: '$', String: "bytes"}
+	if bs, err := m.ToBytes(); err != nil || string(bs) != "bytes" {
+		t.Fatalf("unexpected bytes %v %v", bs, err)
+	}
+	if bs, err := NewResult(Message{Type: '=', String: "txt:bytes"}, nil).ToBytes(); err != nil || string(bs) != "bytes" {
+		t.Fatalf("unexpected bytes %v %v", bs, err)
+	}
+	if _, err := NewResult(Message{Type: ':'}, nil).ToBytes(); !errors.Is(err, ErrParse) {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
@@ -1,6 +1,8 @@
 package rueidis
 
 import (
+	"context"
+	"io"
 	"net"
 	"sync"
 	"sync/atomic"
@@ -34,12 +36,20 @@ type mux struct {
 	dst  string
 	pool *pool
 	dead wire
-	wire atomic.Value
-	mu   sync.Mutex
-	sc   *singleconnect
+	wire []atomic.Value
+	mu   []sync.Mutex
+	sc   []*singleconnect
+	rr   uint32
 
 	wireFn wireFn
 
+	credentialsFn      func(ctx context.Context) (username, password string, err error)
+	credentialsRefresh time.Duration
+
+	checkVersion bool
+	// version is the server version from the HELLO of the latest pipe, cached only if the checkVersion is enabled.
+	version atomic.Value
+
 	onDisconnected atomic.Value
 }
 
@@ -54,8 +64,26 @@ func makeMux(dst string, option ConnOption, dialFn dialFn) *mux {
 }
 
 func newMux(dst string, option ConnOption, dead wire, wireFn wireFn) *mux {
-	m := &mux{dst: dst, dead: dead, wireFn: wireFn}
-	m.wire.Store(dead)
+	multiplex := 1
+	if option.PipelineMultiplex > 0 {
+		if option.PipelineMultiplex > MaxPipelineMultiplex {
+			option.PipelineMultiplex = MaxPipelineMultiplex
+		}
+		multiplex = 1 << option.PipelineMultiplex
+	}
+	m := &mux{dst: dst, dead: dead, wireFn: wireFn,
+		wire: make([]atomic.Value, multiplex),
+		mu:   make([]sync.Mutex, multiplex),
+		sc:   make([]*singleconnect, multiplex),
+
+		credentialsFn:      option.CredentialsFn,
+		credentialsRefresh: option.CredentialsRefresh,
+
+		checkVersion: option.CheckServerVersion,
+	}
+	for i := range m.wire {
+		m.wire[i].Store(dead)
+	}
 	m.pool = newPool(option.BlockingPoolSize, m._newPooledWire)
 	return m
 }
@@ -68,34 +96,45 @@ retry:
 	goto retry
 }
 
-func (m *mux) _pipe() (w wire, err error) {
-	if w = m.wire.Load().(wire); w != m.dead {
+func (m *mux) _pipe(i uint32) (w wire, err error) {
+	if w = m.wire[i].Load().(wire); w != m.dead {
 		return w, nil
 	}
 
-	m.mu.Lock()
-	sc := m.sc
-	if m.sc == nil {
-		m.sc = &singleconnect{}
-		m.sc.g.Add(1)
+	m.mu[i].Lock()
+	sc := m.sc[i]
+	if m.sc[i] == nil {
+		m.sc[i] = &singleconnect{}
+		m.sc[i].g.Add(1)
 	}
-	m.mu.Unlock()
+	m.mu[i].Unlock()
 
 	if sc != nil {
 		sc.g.Wait()
 		return sc.w, sc.e
 	}
 
-	if w = m.wire.Load().(wire); w == m.dead {
-		if w, err = m.wireFn(m.disconnected); err == nil {
-			m.wire.Store(w)
+	if w = m.wire[i].Load().(wire); w == m.dead {
+		// only the first pipe carries pubsub subscriptions, so only its disconnection is reported
+		var onDisconnected func(err error)
+		if i == 0 {
+			onDisconnected = m.disconnected
+		}
+		if w, err = m.wireFn(onDisconnected); err == nil {
+			if m.checkVersion {
+				m.version.Store(serverVersion(w.Info()))
+			}
+			m.wire[i].Store(w)
+			if m.credentialsFn != nil && m.credentialsRefresh > 0 {
+				go m.reauth(i, w)
+			}
 		}
 	}
 
-	m.mu.Lock()
-	sc = m.sc
-	m.sc = nil
-	m.mu.Unlock()
+	m.mu[i].Lock()
+	sc = m.sc[i]
+	m.sc[i] = nil
+	m.mu[i].Unlock()
 
 	sc.w = w
 	sc.e = err
@@ -104,6 +143,29 @@ func (m *mux) _pipe() (w wire, err error) {
 	return w, err
 }
 
+// reauth periodically sends AUTH with the latest credentials through the pipe until the pipe is replaced or closed.
+// The AUTH is just pipelined with other commands, and its failure is ignored because
+// the pipe will get the latest credentials again when reconnecting.
+func (m *mux) reauth(i uint32, w wire) {
+	ticker := time.NewTicker(m.credentialsRefresh)
+	defer ticker.Stop()
+	for range ticker.C {
+		if m.wire[i].Load() != w || w.Error() != nil {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), m.credentialsRefresh)
+		username, password, err := m.credentialsFn(ctx)
+		cancel()
+		if err != nil {
+			continue
+		}
+		if username == "" {
+			username = "default"
+		}
+		w.Do(cmds.NewCompleted([]string{"AUTH", username, password}))
+	}
+}
+
 func (m *mux) disconnected(err error) {
 	if fn := m.onDisconnected.Load(); fn != nil {
 		fn.(func(err error))(err)
@@ -114,28 +176,54 @@ func (m *mux) OnDisconnected(fn func(err error)) {
 	m.onDisconnected.CompareAndSwap(nil, fn)
 }
 
-func (m *mux) pipe() wire {
+func (m *mux) pipe(i uint32) wire {
 retry:
-	if wire, err := m._pipe(); err == nil {
+	if wire, err := m._pipe(i); err == nil {
 		return wire
 	}
 	goto retry
 }
 
+// next picks the pipe index for a command in a round-robin manner.
+// Commands without reply, such as SUBSCRIBE, always go to the first pipe where the push messages are handled.
+func (m *mux) next(noReply bool) uint32 {
+	if noReply || len(m.wire) == 1 {
+		return 0
+	}
+	return atomic.AddUint32(&m.rr, 1) & uint32(len(m.wire)-1)
+}
+
 func (m *mux) Dial() error { // no retry
-	_, err := m._pipe()
+	_, err := m._pipe(0)
 	return err
 }
 
 func (m *mux) Info() map[string]proto.Message {
-	return m.pipe().Info()
+	return m.pipe(0).Info()
 }
 
 func (m *mux) Error() error {
-	return m.pipe().Error()
+	return m.pipe(0).Error()
+}
+
+// serverVersion returns the cached server version, and only dials the first pipe once if no pipe has been connected yet.
+// It returns an empty version if the dial fails, so that the command is not checked but fails with the network error.
+func (m *mux) serverVersion() string {
+	if v := m.version.Load(); v != nil {
+		return v.(string)
+	}
+	if w, err := m._pipe(0); err == nil {
+		return serverVersion(w.Info())
+	}
+	return ""
 }
 
 func (m *mux) Do(cmd cmds.Completed) (resp proto.Result) {
+	if m.checkVersion {
+		if err := checkServerVersion(m.serverVersion(), cmd.Commands()); err != nil {
+			return proto.NewErrResult(err)
+		}
+	}
 retry:
 	if cmd.IsBlock() {
 		resp = m.blocking(cmd)
@@ -149,6 +237,18 @@ retry:
 }
 
 func (m *mux) DoMulti(multi ...cmds.Completed) (resp []proto.Result) {
+	if m.checkVersion {
+		version := m.serverVersion()
+		for _, cmd := range multi {
+			if err := checkServerVersion(version, cmd.Commands()); err != nil {
+				resp = make([]proto.Result, len(multi))
+				for i := range resp {
+					resp[i] = proto.NewErrResult(err)
+				}
+				return resp
+			}
+		}
+	}
 	var block, write bool
 	for _, cmd := range multi {
 		block = block || cmd.IsBlock()
@@ -185,19 +285,25 @@ func (m *mux) blockingMulti(cmd []cmds.Completed) (resp []proto.Result) {
 }
 
 func (m *mux) pipeline(cmd cmds.Completed) (resp proto.Result) {
-	wire := m.pipe()
+	i := m.next(cmd.NoReply())
+	wire := m.pipe(i)
 	if resp = wire.Do(cmd); isNetworkErr(resp.NonRedisError()) {
-		m.wire.CompareAndSwap(wire, m.dead)
+		m.wire[i].CompareAndSwap(wire, m.dead)
 	}
 	return resp
 }
 
 func (m *mux) pipelineMulti(cmd []cmds.Completed) (resp []proto.Result) {
-	wire := m.pipe()
+	var noReply bool
+	for _, c := range cmd {
+		noReply = noReply || c.NoReply()
+	}
+	i := m.next(noReply)
+	wire := m.pipe(i)
 	resp = wire.DoMulti(cmd...)
 	for _, r := range resp {
 		if isNetworkErr(r.NonRedisError()) {
-			m.wire.CompareAndSwap(wire, m.dead)
+			m.wire[i].CompareAndSwap(wire, m.dead)
 			return resp
 		}
 	}
@@ -205,16 +311,40 @@ func (m *mux) pipelineMulti(cmd []cmds.Completed) (resp []proto.Result) {
 }
 
 func (m *mux) DoCache(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+	if m.checkVersion {
+		if err := checkServerVersion(m.serverVersion(), cmd.Commands()); err != nil {
+			return proto.NewErrResult(err)
+		}
+	}
+	// the same key always goes to the same pipe, so that it is cached and invalidated only once
+	var i uint32
+	if len(m.wire) != 1 {
+		ck, _ := cmd.CacheKey()
+		i = uint32(cmds.Slot(ck)) & uint32(len(m.wire)-1)
+	}
 retry:
-	wire := m.pipe()
+	wire := m.pipe(i)
 	resp := wire.DoCache(cmd, ttl)
 	if isNetworkErr(resp.NonRedisError()) {
-		m.wire.CompareAndSwap(wire, m.dead)
+		m.wire[i].CompareAndSwap(wire, m.dead)
 		goto retry
 	}
 	return resp
 }
 
+// DoStream uses a connection from the blocking pool, because the reply must be read exclusively.
+func (m *mux) DoStream(ctx context.Context, cmd cmds.Completed, w io.Writer) (n int64, err error) {
+	if m.checkVersion {
+		if err = checkServerVersion(m.serverVersion(), cmd.Commands()); err != nil {
+			return 0, err
+		}
+	}
+	wire := m.pool.Acquire()
+	n, err = wire.DoStream(ctx, cmd, w)
+	m.pool.Store(wire)
+	return n, err
+}
+
 func (m *mux) Acquire() wire {
 	return m.pool.Acquire()
 }
@@ -223,8 +353,13 @@ func (m *mux) Store(w wire) {
 	m.pool.Store(w)
 }
 
+// Close closes only the live pipes, so that the ones never used or already broken are not dialed just to be closed.
 func (m *mux) Close() {
-	m.pipe().Close()
+	for i := range m.wire {
+		if w := m.wire[i].Load().(wire); w != m.dead {
+			w.Close()
+		}
+	}
 	m.pool.Close()
 }
 
@@ -2,9 +2,13 @@ package rueidis
 
 import (
 	"bufio"
+	"context"
 	"errors"
+	"io"
 	"net"
+	"reflect"
 	"runtime"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -84,6 +88,133 @@ func TestMuxOnDisconnected(t *testing.T) {
 	}
 }
 
+func TestMuxPipelineMultiplex(t *testing.T) {
+	setup := func(multiplex int) (*mux, *[]func(err error), *[]int64) {
+		var mu sync.Mutex
+		var triggers []func(err error)
+		var counts []int64
+		m := newMux("", ConnOption{PipelineMultiplex: multiplex}, (*mock.Wire)(nil), func(fn func(err error)) (wire, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			i := len(counts)
+			counts = append(counts, 0)
+			triggers = append(triggers, fn)
+			return &mock.Wire{
+				DoFn: func(cmd cmds.Completed) proto.Result {
+					mu.Lock()
+					counts[i]++
+					mu.Unlock()
+					return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+				},
+				DoCacheFn: func(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+					return proto.NewResult(proto.Message{Type: ':', Integer: int64(i)}, nil)
+				},
+			}, nil
+		})
+		return m, &triggers, &counts
+	}
+	t.Run("round robin", func(t *testing.T) {
+		m, _, counts := setup(2)
+		defer m.Close()
+		for i := 0; i < 8; i++ {
+			if err := m.Do(cmds.NewCompleted([]string{"PING"})).Error(); err != nil {
+				t.Fatalf("unexpected err %v", err)
+			}
+		}
+		if len(*counts) != 4 {
+			t.Fatalf("unexpected number of pipes %v", len(*counts))
+		}
+		for _, c := range *counts {
+			if c != 2 {
+				t.Fatalf("commands are not spread evenly %v", *counts)
+			}
+		}
+	})
+	t.Run("no reply command goes to first pipe", func(t *testing.T) {
+		m, _, counts := setup(2)
+		defer m.Close()
+		for i := 0; i < 4; i++ {
+			m.Do(cmds.NewBuilder().Subscribe().Channel("ch").Build())
+		}
+		if len(*counts) != 1 || (*counts)[0] != 4 {
+			t.Fatalf("unexpected distribution %v", *counts)
+		}
+	})
+	t.Run("cache key sticks to one pipe", func(t *testing.T) {
+		m, _, _ := setup(3)
+		defer m.Close()
+		cmd := cmds.NewBuilder().Get().Key("a").Cache()
+		first, _ := m.DoCache(cmd, time.Second).ToInt64()
+		for i := 0; i < 10; i++ {
+			if v, _ := m.DoCache(cmd, time.Second).ToInt64(); v != first {
+				t.Fatalf("cache key goes to different pipes %v and %v", first, v)
+			}
+		}
+	})
+	t.Run("only first pipe reports disconnection", func(t *testing.T) {
+		m, triggers, _ := setup(1)
+		defer m.Close()
+		if err := m.Dial(); err != nil {
+			t.Fatalf("unexpected dial error %v", err)
+		}
+		for i := 0; i < 2; i++ {
+			m.Do(cmds.NewCompleted([]string{"PING"}))
+		}
+		if len(*triggers) != 2 || (*triggers)[0] == nil || (*triggers)[1] != nil {
+			t.Fatalf("unexpected disconnected callbacks")
+		}
+	})
+	t.Run("close only live pipes", func(t *testing.T) {
+		m, _, counts := setup(2)
+		m.Do(cmds.NewCompleted([]string{"PING"}))
+		m.Close()
+		if len(*counts) != 1 {
+			t.Fatalf("unused pipes are dialed on close %v", len(*counts))
+		}
+	})
+	t.Run("max multiplex", func(t *testing.T) {
+		m, _, _ := setup(100)
+		if len(m.wire) != 1<<MaxPipelineMultiplex {
+			t.Fatalf("unexpected number of pipes %v", len(m.wire))
+		}
+	})
+}
+
+func TestMuxCredentialsRefresh(t *testing.T) {
+	auth := make(chan []string, 1)
+	var closed int32
+	m := newMux("", ConnOption{
+		CredentialsFn: func(ctx context.Context) (string, string, error) {
+			return "", "token", nil
+		},
+		CredentialsRefresh: time.Millisecond,
+	}, (*mock.Wire)(nil), func(fn func(err error)) (wire, error) {
+		return &mock.Wire{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				select {
+				case auth <- cmd.Commands():
+				default:
+				}
+				return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+			},
+			ErrorFn: func() error {
+				if atomic.LoadInt32(&closed) == 1 {
+					return ErrConnClosing
+				}
+				return nil
+			},
+		}, nil
+	})
+	if err := m.Dial(); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if cmd := <-auth; !reflect.DeepEqual(cmd, []string{"AUTH", "default", "token"}) {
+		t.Fatalf("unexpected command %v", cmd)
+	}
+	atomic.StoreInt32(&closed, 1)
+	m.Close()
+}
+
 func TestMuxDialSuppress(t *testing.T) {
 	var wires, waits, done int64
 	blocking := make(chan struct{})
@@ -180,6 +311,97 @@ func TestMuxReuseWire(t *testing.T) {
 	})
 }
 
+func TestMuxDoStream(t *testing.T) {
+	m, checkClean := setupMux([]*mock.Wire{
+		{
+			// leave first wire for pipeline calls
+		},
+		{
+			DoStreamFn: func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+				n, err := io.WriteString(w, "STREAM")
+				return int64(n), err
+			},
+		},
+	})
+	defer checkClean(t)
+	defer m.Close()
+	if err := m.Dial(); err != nil {
+		t.Fatalf("unexpected dial error %v", err)
+	}
+	for i := 0; i < 2; i++ {
+		buf := &strings.Builder{}
+		if n, err := m.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), buf); err != nil || n != 6 || buf.String() != "STREAM" {
+			t.Fatalf("unexpected response %v %v %v", n, err, buf.String())
+		}
+	}
+}
+
+func TestMuxCheckServerVersion(t *testing.T) {
+	infos := 0
+	w := &mock.Wire{
+		InfoFn: func() map[string]proto.Message {
+			infos++
+			return map[string]proto.Message{"version": {Type: '+', String: "6.0.16"}}
+		},
+		DoFn: func(cmd cmds.Completed) proto.Result {
+			return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+		},
+	}
+	m := newMux("", ConnOption{CheckServerVersion: true}, (*mock.Wire)(nil), func(fn func(err error)) (wire, error) {
+		return w, nil
+	})
+	defer m.Close()
+	if err := m.Dial(); err != nil {
+		t.Fatalf("unexpected dial error %v", err)
+	}
+	if v, err := m.Do(cmds.NewCompleted([]string{"GET", "a"})).ToString(); err != nil || v != "OK" {
+		t.Fatalf("unexpected response %v %v", v, err)
+	}
+	var ve *VersionError
+	if err := m.Do(cmds.NewCompleted([]string{"LMPOP", "1", "a", "LEFT"})).Error(); !errors.As(err, &ve) || ve.Command != "LMPOP" || ve.Since != "7.0.0" || ve.ServerVersion != "6.0.16" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	for _, resp := range m.DoMulti(cmds.NewCompleted([]string{"GET", "a"}), cmds.NewCompleted([]string{"LMPOP", "1", "a", "LEFT"})) {
+		if err := resp.Error(); !errors.As(err, &ve) {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	if err := m.DoCache(cmds.Cacheable(cmds.NewCompleted([]string{"GETEX", "a"})), time.Second).Error(); !errors.As(err, &ve) || ve.Command != "GETEX" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if _, err := m.DoStream(context.Background(), cmds.NewCompleted([]string{"GETDEL", "a"}), io.Discard); !errors.As(err, &ve) || ve.Command != "GETDEL" {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if infos != 1 {
+		t.Fatalf("unexpected server version lookups %v", infos)
+	}
+}
+
+func TestMuxCheckServerVersionWithoutDial(t *testing.T) {
+	dials := 0
+	m := newMux("", ConnOption{CheckServerVersion: true}, (*mock.Wire)(nil), func(fn func(err error)) (wire, error) {
+		dials++
+		return &mock.Wire{
+			InfoFn: func() map[string]proto.Message {
+				return map[string]proto.Message{"version": {Type: '+', String: "6.0.16"}}
+			},
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+			},
+		}, nil
+	})
+	defer m.Close()
+	var ve *VersionError
+	for i := 0; i < 2; i++ {
+		if err := m.Do(cmds.NewCompleted([]string{"LMPOP", "1", "a", "LEFT"})).Error(); !errors.As(err, &ve) {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	if dials != 1 {
+		t.Fatalf("unexpected dials %v", dials)
+	}
+}
+
 func TestMuxCMDRetry(t *testing.T) {
 	t.Run("wire info", func(t *testing.T) {
 		m, checkClean := setupMux([]*mock.Wire{
@@ -0,0 +1,110 @@
+package rueidis
+
+import (
+	"context"
+	"sync"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+// NodeRole is the role of a node in the cluster.
+type NodeRole string
+
+const (
+	RoleMaster  NodeRole = "master"
+	RoleReplica NodeRole = "replica"
+	// RoleAny matches both masters and replicas in the ForEachNode.
+	RoleAny NodeRole = ""
+)
+
+// NodeClient sends commands to a single node of the cluster regardless of the slots of their keys.
+// It is only valid in the ForEachNode callback.
+type NodeClient struct {
+	Cmd  *cmds.Builder
+	Node ClusterNode
+	conn conn
+}
+
+func (n *NodeClient) Do(ctx context.Context, cmd cmds.Completed) (resp proto.Result) {
+	resp = n.conn.Do(cmd)
+	n.Cmd.Put(cmd.Commands())
+	return resp
+}
+
+func (n *NodeClient) DoMulti(ctx context.Context, multi ...cmds.Completed) (resp []proto.Result) {
+	resp = n.conn.DoMulti(multi...)
+	for _, cmd := range multi {
+		n.Cmd.Put(cmd.Commands())
+	}
+	return resp
+}
+
+// ForEachNode calls the fn concurrently with a NodeClient for each node of the role in the current topology,
+// and returns the first error in the order of the node addresses. The masters are reached with the existing connections,
+// while the connections to the replicas are made on the first call, kept for later calls and closed once the replicas
+// leave the topology.
+func (c *ClusterClient) ForEachNode(ctx context.Context, role NodeRole, fn func(ctx context.Context, node *NodeClient) error) error {
+	if !c.busy.enter() {
+		return ErrConnClosing
+	}
+	defer c.busy.leave()
+
+	var nodes []ClusterNode
+	for _, n := range c.Nodes() {
+		if role == RoleAny || n.Role == role {
+			nodes = append(nodes, n)
+		}
+	}
+	errs := make([]error, len(nodes))
+	wg := sync.WaitGroup{}
+	wg.Add(len(nodes))
+	for i, n := range nodes {
+		go func(i int, n ClusterNode) {
+			errs[i] = c.forNode(ctx, n, fn)
+			wg.Done()
+		}(i, n)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *ClusterClient) forNode(ctx context.Context, n ClusterNode, fn func(ctx context.Context, node *NodeClient) error) error {
+	c.mu.Lock()
+	cc := c.conns[n.Addr]
+	if cc == nil {
+		if c.replicas == nil {
+			c.replicas = make(map[string]conn)
+		}
+		if cc = c.replicas[n.Addr]; cc == nil {
+			cc = c.connFn(n.Addr, c.opt.ConnOption)
+			c.replicas[n.Addr] = cc
+		}
+	}
+	c.mu.Unlock()
+	if err := cc.Dial(); err != nil {
+		return err
+	}
+	return fn(ctx, &NodeClient{Cmd: (*cmds.Builder)(c.Cmd), Node: n, conn: cc})
+}
+
+// Broadcast sends the cmd to every master concurrently, such as the FLUSHALL, SCRIPT LOAD or CONFIG SET,
+// and returns their results keyed by the node addresses.
+func (c *ClusterClient) Broadcast(ctx context.Context, cmd cmds.SCompleted) (map[string]proto.Result, error) {
+	mu := sync.Mutex{}
+	results := make(map[string]proto.Result)
+	err := c.ForEachNode(ctx, RoleMaster, func(ctx context.Context, node *NodeClient) error {
+		resp := node.conn.Do(cmds.Completed(cmd))
+		mu.Lock()
+		results[node.Node.Addr] = resp
+		mu.Unlock()
+		return nil
+	})
+	c.Cmd.Put(cmd.Commands())
+	return results, err
+}
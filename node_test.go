@@ -0,0 +1,164 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func slotsOf(ranges ...[]interface{}) proto.Result {
+	values := make([]proto.Message, 0, len(ranges))
+	for _, r := range ranges {
+		v := proto.Message{Type: '*', Values: []proto.Message{{Type: ':', Integer: int64(r[0].(int))}, {Type: ':', Integer: int64(r[1].(int))}}}
+		for _, port := range r[2:] {
+			v.Values = append(v.Values, proto.Message{Type: '*', Values: []proto.Message{
+				{Type: '+', String: "127.0.0.1"},
+				{Type: ':', Integer: int64(port.(int))},
+			}})
+		}
+		values = append(values, v)
+	}
+	return proto.NewResult(proto.Message{Type: '*', Values: values}, nil)
+}
+
+func TestClusterClientForEachNode(t *testing.T) {
+	var closed int64
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:1"}}, func(dst string, opt ConnOption) conn {
+		return &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					return slotsOf([]interface{}{0, 8191, 1, 3}, []interface{}{8192, 16383, 2})
+				}
+				return proto.NewResult(proto.Message{Type: '+', String: dst}, nil)
+			},
+			CloseFn: func() {
+				atomic.AddInt64(&closed, 1)
+			},
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+
+	t.Run("ForEachNode", func(t *testing.T) {
+		for role, expected := range map[NodeRole][]string{
+			RoleMaster:  {"127.0.0.1:1", "127.0.0.1:2"},
+			RoleReplica: {"127.0.0.1:3"},
+			RoleAny:     {"127.0.0.1:1", "127.0.0.1:2", "127.0.0.1:3"},
+		} {
+			var mu sync.Mutex
+			var addrs []string
+			if err := client.ForEachNode(context.Background(), role, func(ctx context.Context, node *NodeClient) error {
+				v, err := node.Do(ctx, node.Cmd.Dbsize().Build()).ToString()
+				if err != nil || v != node.Node.Addr {
+					t.Errorf("unexpected resp %v %v", v, err)
+				}
+				mu.Lock()
+				addrs = append(addrs, v)
+				mu.Unlock()
+				return nil
+			}); err != nil {
+				t.Fatalf("unexpected err %v", err)
+			}
+			sort.Strings(addrs)
+			if !reflect.DeepEqual(addrs, expected) {
+				t.Fatalf("unexpected addrs of %q %v", role, addrs)
+			}
+		}
+		if n := atomic.LoadInt64(&closed); n != 0 {
+			t.Fatalf("the replica conns should be kept for later calls, but got %v closed", n)
+		}
+		if len(client.replicas) != 1 || client.replicas["127.0.0.1:3"] == nil {
+			t.Fatalf("unexpected replica conns %v", client.replicas)
+		}
+	})
+
+	t.Run("ForEachNode err", func(t *testing.T) {
+		v := errors.New("fn err")
+		if err := client.ForEachNode(context.Background(), RoleMaster, func(ctx context.Context, node *NodeClient) error {
+			if node.Node.Addr == "127.0.0.1:2" {
+				return v
+			}
+			return nil
+		}); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
+	t.Run("Broadcast", func(t *testing.T) {
+		results, err := client.Broadcast(context.Background(), client.Cmd.Flushall().Build())
+		if err != nil || len(results) != 2 {
+			t.Fatalf("unexpected results %v %v", results, err)
+		}
+		for addr, r := range results {
+			if v, err := r.ToString(); err != nil || v != addr {
+				t.Fatalf("unexpected resp %v %v", v, err)
+			}
+		}
+	})
+
+	t.Run("Reject after Close", func(t *testing.T) {
+		client.Close(context.Background())
+		if err := client.ForEachNode(context.Background(), RoleAny, func(ctx context.Context, node *NodeClient) error {
+			return nil
+		}); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if _, err := client.Broadcast(context.Background(), client.Cmd.Flushall().Build()); err != ErrConnClosing {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+}
+
+func TestClusterClientReplicaConns(t *testing.T) {
+	var made, closed, removed int64
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:1"}}, func(dst string, opt ConnOption) conn {
+		if dst == "127.0.0.1:3" {
+			atomic.AddInt64(&made, 1)
+		}
+		return &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				if atomic.LoadInt64(&removed) == 1 {
+					return slotsOf([]interface{}{0, 16383, 1})
+				}
+				return slotsOf([]interface{}{0, 16383, 1, 3})
+			},
+			CloseFn: func() {
+				if dst == "127.0.0.1:3" {
+					atomic.AddInt64(&closed, 1)
+				}
+			},
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		if err := client.ForEachNode(context.Background(), RoleReplica, func(ctx context.Context, node *NodeClient) error {
+			return nil
+		}); err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+	}
+	if n := atomic.LoadInt64(&made); n != 1 {
+		t.Fatalf("the replica conn should be reused, but made %v", n)
+	}
+	atomic.StoreInt64(&removed, 1)
+	if err := client.refresh(); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	for atomic.LoadInt64(&closed) != 1 {
+		runtime.Gosched()
+	}
+	if len(client.replicas) != 0 {
+		t.Fatalf("unexpected replica conns %v", client.replicas)
+	}
+}
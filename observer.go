@@ -0,0 +1,182 @@
+package rueidis
+
+import (
+	"sort"
+	"sync/atomic"
+)
+
+// ClusterEventType is the type of the ClusterEvent.
+type ClusterEventType int
+
+const (
+	// EventNodeAdded is emitted when a master or replica appears in the topology.
+	EventNodeAdded ClusterEventType = iota + 1
+	// EventNodeRemoved is emitted when a master or replica disappears from the topology.
+	EventNodeRemoved
+	// EventMasterChanged is emitted when a range of slots is served by another master, such as after a failover or resharding.
+	EventMasterChanged
+	// EventMoved is emitted when a command is redirected by a MOVED reply.
+	EventMoved
+	// EventAsk is emitted when a command is redirected by an ASK reply.
+	EventAsk
+	// EventRefreshFailed is emitted when the topology can't be refreshed.
+	EventRefreshFailed
+)
+
+func (t ClusterEventType) String() string {
+	switch t {
+	case EventNodeAdded:
+		return "NodeAdded"
+	case EventNodeRemoved:
+		return "NodeRemoved"
+	case EventMasterChanged:
+		return "MasterChanged"
+	case EventMoved:
+		return "Moved"
+	case EventAsk:
+		return "Ask"
+	case EventRefreshFailed:
+		return "RefreshFailed"
+	}
+	return "Unknown"
+}
+
+// ClusterEvent is passed to the ClusterClientOption.Observer.
+type ClusterEvent struct {
+	Type ClusterEventType
+	// Addr is the node added or removed, the new master of the Slots, or the target of the redirection.
+	Addr string
+	// PrevAddr is the previous master of the Slots of the EventMasterChanged. It is empty if the Slots were not covered.
+	PrevAddr string
+	// Slots is the first and the last slot of the EventMasterChanged, or the redirected slot of the EventMoved and EventAsk.
+	Slots [2]uint16
+	// Err is the error of the EventRefreshFailed.
+	Err error
+}
+
+// ClusterShard is a master of the cluster topology along with its slot ranges and replicas.
+type ClusterShard struct {
+	// Slots are the first and the last slots of the ranges served by the Master.
+	Slots    [][2]uint16
+	Master   ClusterNode
+	Replicas []ClusterNode
+	// Redirects are the numbers of MOVED and ASK redirections received for the Slots since the client is created.
+	// Only the slots ever redirected are included, and it is nil if there is none.
+	Redirects map[uint16]SlotRedirects
+}
+
+// SlotRedirects is the number of MOVED and ASK redirections received for a slot.
+type SlotRedirects struct {
+	Moved uint32
+	Ask   uint32
+}
+
+// Topology returns a snapshot of the cluster topology from the last refresh, sorted by the slots.
+// The snapshot is a deep copy, so it can be modified freely.
+func (c *ClusterClient) Topology() []ClusterShard {
+	c.mu.RLock()
+	shards := make([]ClusterShard, len(c.shards))
+	for i, s := range c.shards {
+		shards[i] = ClusterShard{
+			Slots:    append(make([][2]uint16, 0, len(s.Slots)), s.Slots...),
+			Master:   s.Master,
+			Replicas: append(make([]ClusterNode, 0, len(s.Replicas)), s.Replicas...),
+		}
+	}
+	c.mu.RUnlock()
+	for i, s := range shards {
+		for _, r := range s.Slots {
+			for slot := int(r[0]); slot <= int(r[1]); slot++ {
+				moved := atomic.LoadUint32(&c.redirects[slot][0])
+				ask := atomic.LoadUint32(&c.redirects[slot][1])
+				if moved == 0 && ask == 0 {
+					continue
+				}
+				if shards[i].Redirects == nil {
+					shards[i].Redirects = make(map[uint16]SlotRedirects)
+				}
+				shards[i].Redirects[uint16(slot)] = SlotRedirects{Moved: moved, Ask: ask}
+			}
+		}
+	}
+	return shards
+}
+
+func (c *ClusterClient) emit(event ClusterEvent) {
+	if c.opt.Observer != nil {
+		c.opt.Observer(event)
+	}
+}
+
+// emitRedirect counts the redirection of the slot for the Topology, and emits it to the Observer.
+func (c *ClusterClient) emitRedirect(typ ClusterEventType, slot uint16, addr string) {
+	if typ == EventMoved {
+		atomic.AddUint32(&c.redirects[slot][0], 1)
+	} else {
+		atomic.AddUint32(&c.redirects[slot][1], 1)
+	}
+	if c.opt.Observer != nil {
+		c.opt.Observer(ClusterEvent{Type: typ, Addr: c.remap(addr), Slots: [2]uint16{slot, slot}})
+	}
+}
+
+// diffShards returns the events of the nodes added or removed and the slot ranges whose masters are changed.
+func diffShards(prev, next []ClusterShard) (events []ClusterEvent) {
+	prevNodes, nextNodes := shardNodes(prev), shardNodes(next)
+	for _, addr := range sortedKeys(nextNodes) {
+		if !prevNodes[addr] {
+			events = append(events, ClusterEvent{Type: EventNodeAdded, Addr: addr})
+		}
+	}
+	for _, addr := range sortedKeys(prevNodes) {
+		if !nextNodes[addr] {
+			events = append(events, ClusterEvent{Type: EventNodeRemoved, Addr: addr})
+		}
+	}
+
+	prevMasters, nextMasters := slotMasters(prev), slotMasters(next)
+	for i := 0; i < len(nextMasters); i++ {
+		if prevMasters[i] == nextMasters[i] {
+			continue
+		}
+		j := i
+		for j+1 < len(nextMasters) && prevMasters[j+1] == prevMasters[i] && nextMasters[j+1] == nextMasters[i] {
+			j++
+		}
+		events = append(events, ClusterEvent{Type: EventMasterChanged, Addr: nextMasters[i], PrevAddr: prevMasters[i], Slots: [2]uint16{uint16(i), uint16(j)}})
+		i = j
+	}
+	return events
+}
+
+func shardNodes(shards []ClusterShard) map[string]bool {
+	nodes := make(map[string]bool)
+	for _, s := range shards {
+		nodes[s.Master.Addr] = true
+		for _, r := range s.Replicas {
+			nodes[r.Addr] = true
+		}
+	}
+	return nodes
+}
+
+func slotMasters(shards []ClusterShard) *[16384]string {
+	masters := new([16384]string)
+	for _, s := range shards {
+		for _, r := range s.Slots {
+			for i := int(r[0]); i <= int(r[1]); i++ {
+				masters[i] = s.Master.Addr
+			}
+		}
+	}
+	return masters
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
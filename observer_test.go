@@ -0,0 +1,144 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func TestClusterClientObserver(t *testing.T) {
+	var stage int64
+	var mu sync.Mutex
+	var events []ClusterEvent
+	v := errors.New("refresh err")
+	client, err := newClusterClient(ClusterClientOption{
+		InitAddress: []string{"127.0.0.1:1"},
+		Observer: func(event ClusterEvent) {
+			mu.Lock()
+			events = append(events, event)
+			mu.Unlock()
+		},
+	}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] == "CLUSTER" {
+				switch atomic.LoadInt64(&stage) {
+				case 0:
+					return slotsOf([]interface{}{0, 8191, 1, 3}, []interface{}{8192, 16383, 2})
+				case 1:
+					return slotsOf([]interface{}{0, 4095, 1}, []interface{}{4096, 16383, 2, 4})
+				default:
+					return proto.NewErrResult(v)
+				}
+			}
+			return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	check := func(expected []ClusterEvent) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		if !reflect.DeepEqual(events, expected) {
+			t.Fatalf("unexpected events %v", events)
+		}
+		events = nil
+	}
+
+	check([]ClusterEvent{
+		{Type: EventNodeAdded, Addr: "127.0.0.1:1"},
+		{Type: EventNodeAdded, Addr: "127.0.0.1:2"},
+		{Type: EventNodeAdded, Addr: "127.0.0.1:3"},
+		{Type: EventMasterChanged, Addr: "127.0.0.1:1", Slots: [2]uint16{0, 8191}},
+		{Type: EventMasterChanged, Addr: "127.0.0.1:2", Slots: [2]uint16{8192, 16383}},
+	})
+	if shards := client.Topology(); !reflect.DeepEqual(shards, []ClusterShard{
+		{Slots: [][2]uint16{{0, 8191}}, Master: ClusterNode{Addr: "127.0.0.1:1", Role: RoleMaster, Health: "online"}, Replicas: []ClusterNode{{Addr: "127.0.0.1:3", Role: RoleReplica, Health: "online"}}},
+		{Slots: [][2]uint16{{8192, 16383}}, Master: ClusterNode{Addr: "127.0.0.1:2", Role: RoleMaster, Health: "online"}, Replicas: []ClusterNode{}},
+	}) {
+		t.Fatalf("unexpected topology %v", shards)
+	}
+	// the snapshot is a deep copy, so modifying it does not affect the next diff
+	snapshot := client.Topology()
+	snapshot[0].Slots[0] = [2]uint16{0, 16383}
+	snapshot[0].Replicas[0].Addr = "127.0.0.1:9"
+	if shards := client.Topology(); shards[0].Slots[0] != [2]uint16{0, 8191} || shards[0].Replicas[0].Addr != "127.0.0.1:3" {
+		t.Fatalf("unexpected topology %v", shards)
+	}
+
+	atomic.StoreInt64(&stage, 1)
+	if err := client.refresh(); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	check([]ClusterEvent{
+		{Type: EventNodeAdded, Addr: "127.0.0.1:4"},
+		{Type: EventNodeRemoved, Addr: "127.0.0.1:3"},
+		{Type: EventMasterChanged, Addr: "127.0.0.1:2", PrevAddr: "127.0.0.1:1", Slots: [2]uint16{4096, 8191}},
+	})
+
+	if err := client.refresh(); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	check(nil)
+
+	atomic.StoreInt64(&stage, 2)
+	if err := client.refresh(); err != v {
+		t.Fatalf("unexpected err %v", err)
+	}
+	check([]ClusterEvent{{Type: EventRefreshFailed, Err: v}})
+}
+
+func TestClusterClientObserverRedirect(t *testing.T) {
+	var mu sync.Mutex
+	var events []ClusterEvent
+	count := 0
+	client, err := newClusterClient(ClusterClientOption{
+		InitAddress: []string{":0"},
+		Observer: func(event ClusterEvent) {
+			if event.Type == EventMoved || event.Type == EventAsk {
+				mu.Lock()
+				events = append(events, event)
+				mu.Unlock()
+			}
+		},
+	}, func(dst string, opt ConnOption) conn {
+		return &MockConn{
+			DoFn: func(cmd cmds.Completed) proto.Result {
+				if cmd.Commands()[0] == "CLUSTER" {
+					return slotsResp
+				}
+				if count++; count == 1 {
+					return proto.NewResult(proto.Message{Type: '-', String: "MOVED 15495 :1"}, nil)
+				}
+				return proto.NewResult(proto.Message{Type: '-', String: "ASK 15495 :2"}, nil)
+			},
+			DoMultiFn: func(multi ...cmds.Completed) []proto.Result {
+				return []proto.Result{{}, proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)}
+			},
+		}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if v, err := client.Do(context.Background(), client.Cmd.Get().Key("a").Build()).ToString(); err != nil || v != "OK" {
+		t.Fatalf("unexpected resp %v %v", v, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if !reflect.DeepEqual(events, []ClusterEvent{
+		{Type: EventMoved, Addr: ":1", Slots: [2]uint16{15495, 15495}},
+		{Type: EventAsk, Addr: ":2", Slots: [2]uint16{15495, 15495}},
+	}) {
+		t.Fatalf("unexpected events %v", events)
+	}
+	if shards := client.Topology(); !reflect.DeepEqual(shards[0].Redirects, map[uint16]SlotRedirects{15495: {Moved: 1, Ask: 1}}) {
+		t.Fatalf("unexpected redirects %v", shards[0].Redirects)
+	}
+}
@@ -2,6 +2,10 @@ package rueidis
 
 import (
 	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"net"
 	"runtime"
 	"strconv"
@@ -23,6 +27,7 @@ type wire interface {
 	Do(cmd cmds.Completed) proto.Result
 	DoCache(cmd cmds.Cacheable, ttl time.Duration) proto.Result
 	DoMulti(multi ...cmds.Completed) []proto.Result
+	DoStream(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error)
 	Info() map[string]proto.Message
 	Error() error
 	Close()
@@ -55,6 +60,12 @@ func newPipe(conn net.Conn, option ConnOption, onDisconnected func(err error)) (
 		option.CacheSizeEachConn = DefaultCacheBytes
 	}
 
+	username, password, err := credentials(option)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
 	p = &pipe{
 		conn:  conn,
 		queue: queue.NewRing(),
@@ -67,8 +78,8 @@ func newPipe(conn net.Conn, option ConnOption, onDisconnected func(err error)) (
 	}
 
 	helloCmd := []string{"HELLO", "3"}
-	if option.Username != "" {
-		helloCmd = append(helloCmd, "AUTH", option.Username, option.Password)
+	if username != "" {
+		helloCmd = append(helloCmd, "AUTH", username, password)
 	}
 	if option.ClientName != "" {
 		helloCmd = append(helloCmd, "SETNAME", option.ClientName)
@@ -93,6 +104,27 @@ func newPipe(conn net.Conn, option ConnOption, onDisconnected func(err error)) (
 	return p, nil
 }
 
+// credentials returns the static Username and Password or the ones from the CredentialsFn.
+// The "default" username is used if only the password is provided.
+func credentials(option ConnOption) (username, password string, err error) {
+	username, password = option.Username, option.Password
+	if option.CredentialsFn != nil {
+		ctx := context.Background()
+		if option.DialTimeout > 0 {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, option.DialTimeout)
+			defer cancel()
+		}
+		if username, password, err = option.CredentialsFn(ctx); err != nil {
+			return "", "", err
+		}
+	}
+	if username == "" && password != "" {
+		username = "default"
+	}
+	return username, password, nil
+}
+
 func (p *pipe) background() {
 	atomic.CompareAndSwapInt32(&p.state, 0, 1)
 	p.once.Do(func() { go p._background() })
@@ -167,7 +199,7 @@ func (p *pipe) _backgroundWrite() {
 			}
 		}
 		for _, cmd := range multi {
-			if err = proto.WriteCmd(p.w, cmd.Commands()); cmd.NoReply() {
+			if err = proto.WriteCmdTo(p.w, p.conn, cmd.Commands()); cmd.NoReply() {
 				ch <- proto.NewErrResult(err)
 			}
 		}
@@ -195,7 +227,10 @@ func (p *pipe) _backgroundRead() {
 			return
 		}
 		if msg.Type == '>' {
-			p.handlePush(msg.Values)
+			if err = p.handlePush(msg.Values); err != nil {
+				p.error.CompareAndSwap(nil, &errs{error: err})
+				return
+			}
 			continue
 		}
 		// if unfulfilled multi commands are lead by opt-in and get success response
@@ -230,9 +265,24 @@ func (p *pipe) _backgroundRead() {
 	}
 }
 
-func (p *pipe) handlePush(values []proto.Message) {
-	if len(values) < 2 {
-		return
+// pushLengths are the number of elements of the push messages handled by the handlePush.
+var pushLengths = map[string]int{
+	"invalidate":   2,
+	"message":      3,
+	"pmessage":     4,
+	"subscribe":    3,
+	"psubscribe":   3,
+	"unsubscribe":  3,
+	"punsubscribe": 3,
+}
+
+// handlePush returns a *ProtocolError if the push message has fewer elements than its kind requires.
+func (p *pipe) handlePush(values []proto.Message) error {
+	if len(values) == 0 {
+		return &proto.ProtocolError{Reason: "empty push message"}
+	}
+	if n, ok := pushLengths[values[0].String]; ok && len(values) < n {
+		return &proto.ProtocolError{Reason: fmt.Sprintf("push message %q with %d elements, expect %d", values[0].String, len(values), n)}
 	}
 	// TODO: handle other push data
 	// tracking-redir-broken
@@ -257,6 +307,7 @@ func (p *pipe) handlePush(values []proto.Message) {
 			p.cbs.onUnSubscribed(values[1].String, values[2].Integer)
 		}
 	}
+	return nil
 }
 
 func (p *pipe) Info() map[string]proto.Message {
@@ -336,7 +387,7 @@ queue:
 
 func (p *pipe) syncDo(cmd cmds.Completed) (resp proto.Result) {
 	var msg proto.Message
-	err := proto.WriteCmd(p.w, cmd.Commands())
+	err := proto.WriteCmdTo(p.w, p.conn, cmd.Commands())
 	if err == nil {
 		if err = p.w.Flush(); err == nil {
 			msg, err = syncRead(p.r)
@@ -355,7 +406,9 @@ func (p *pipe) syncDoMulti(resp []proto.Result, multi []cmds.Completed) []proto.
 	var msg proto.Message
 
 	for _, cmd := range multi {
-		err = proto.WriteCmd(p.w, cmd.Commands())
+		if err = proto.WriteCmdTo(p.w, p.conn, cmd.Commands()); err != nil {
+			goto abort
+		}
 	}
 	if err = p.w.Flush(); err != nil {
 		goto abort
@@ -388,6 +441,70 @@ next:
 	return m, nil
 }
 
+// DoStream sends the cmd and copies its blob string reply to the w directly from the socket.
+// It only works on a pipe without the background worker, such as the pooled ones, because the
+// reply must be the next message on the socket. The pipe is broken if the copying is not completed.
+// The deadline of the ctx is applied to the socket, and the cancellation of the ctx interrupts the socket as well.
+func (p *pipe) DoStream(ctx context.Context, cmd cmds.Completed, w io.Writer) (n int64, err error) {
+	if err = ctx.Err(); err != nil {
+		return 0, err
+	}
+	if atomic.AddInt32(&p.waits, 1) != 1 || atomic.LoadInt32(&p.state) != 0 {
+		atomic.AddInt32(&p.waits, -1)
+		if err = p.Error(); err == nil {
+			err = errStreamOnPipeline
+		}
+		return 0, err
+	}
+	defer atomic.AddInt32(&p.waits, -1)
+
+	if dl, ok := ctx.Deadline(); ok {
+		_ = p.conn.SetDeadline(dl)
+	}
+	if done := ctx.Done(); done != nil {
+		stop, exited := make(chan struct{}), make(chan struct{})
+		go func() {
+			select {
+			case <-done:
+				_ = p.conn.SetDeadline(time.Now()) // unblock the reading or writing
+			case <-stop:
+			}
+			close(exited)
+		}()
+		defer func() {
+			close(stop)
+			<-exited
+			_ = p.conn.SetDeadline(time.Time{})
+		}()
+	}
+
+	var msg proto.Message
+	if err = proto.WriteCmdTo(p.w, p.conn, cmd.Commands()); err == nil {
+		if err = p.w.Flush(); err == nil {
+			for {
+				if n, msg, err = proto.StreamNextMessage(p.r, w); err != nil || msg.Type != '>' {
+					break
+				}
+			}
+		}
+	}
+	if err != nil {
+		p.error.CompareAndSwap(nil, &errs{error: err})
+		p.background()                             // start the background worker
+		atomic.CompareAndSwapInt32(&p.state, 1, 3) // stopping the worker and let it do the cleaning
+		if ctx.Err() == context.Canceled {
+			err = context.Canceled
+		}
+		return n, err
+	}
+	if msg.Type != '$' {
+		if err = msg.Error(); err == nil {
+			err = &proto.ParseError{Target: "stream", Expected: []byte{'$'}, Actual: msg.Type}
+		}
+	}
+	return n, err
+}
+
 func (p *pipe) DoCache(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
 	ck, cc := cmd.CacheKey()
 	if v, entry := p.cache.GetOrPrepare(ck, cc, ttl); v.Type != 0 {
@@ -419,4 +536,6 @@ func (p *pipe) Close() {
 	atomic.CompareAndSwapInt32(&p.state, 2, 3)
 }
 
+var errStreamOnPipeline = errors.New("DoStream is not supported on a pipelined connection")
+
 var protocolbug = "protocol bug, message handled out of order"
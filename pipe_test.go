@@ -2,9 +2,12 @@ package rueidis
 
 import (
 	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"os"
 	"strconv"
 	"strings"
 	"sync"
@@ -189,6 +192,51 @@ func TestNewPipe(t *testing.T) {
 		n1.Close()
 		n2.Close()
 	})
+	t.Run("CredentialsFn", func(t *testing.T) {
+		n1, n2 := net.Pipe()
+		mock := &redisMock{buf: bufio.NewReader(n2), conn: n2}
+		go func() {
+			mock.Expect("HELLO", "3", "AUTH", "default", "token").
+				Reply(proto.Message{
+					Type:   '%',
+					Values: []proto.Message{{Type: '+', String: "key"}, {Type: '+', String: "value"}},
+				})
+			mock.Expect("CLIENT", "TRACKING", "ON", "OPTIN").
+				ReplyString("OK")
+		}()
+		p, err := newPipe(n1, ConnOption{
+			Username:    "un",
+			Password:    "pa",
+			DialTimeout: time.Second,
+			CredentialsFn: func(ctx context.Context) (string, string, error) {
+				if _, ok := ctx.Deadline(); !ok {
+					t.Errorf("ctx should have the DialTimeout")
+				}
+				return "", "token", nil
+			},
+		}, nil)
+		if err != nil {
+			t.Fatalf("pipe setup failed: %v", err)
+		}
+		go func() { mock.Expect("QUIT").ReplyString("OK") }()
+		p.Close()
+		mock.Close()
+		n1.Close()
+		n2.Close()
+	})
+	t.Run("CredentialsFn Error", func(t *testing.T) {
+		n1, n2 := net.Pipe()
+		defer n2.Close()
+		v := errors.New("token err")
+		if _, err := newPipe(n1, ConnOption{
+			CredentialsFn: func(ctx context.Context) (string, string, error) { return "", "", v },
+		}, nil); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if _, err := n1.Write([]byte{0}); err != io.ErrClosedPipe {
+			t.Fatalf("conn should be closed, got %v", err)
+		}
+	})
 	t.Run("Network Error", func(t *testing.T) {
 		n1, n2 := net.Pipe()
 		n1.Close()
@@ -278,6 +326,20 @@ func TestWriteMultiPipelineFlush(t *testing.T) {
 	}
 }
 
+func TestWriteLargeValue(t *testing.T) {
+	p, mock, cancel, _ := setup(t, ConnOption{})
+	defer cancel()
+	large := strings.Repeat("a", proto.DirectWriteLength*2)
+	go func() {
+		mock.Expect("SET", "a", large).ReplyString("OK")
+		mock.Expect("SET", "a", large).Expect("SET", "b", large).ReplyString("OK").ReplyString("OK")
+	}()
+	ExpectOK(t, p.Do(cmds.NewCompleted([]string{"SET", "a", large})))
+	for _, resp := range p.DoMulti(cmds.NewCompleted([]string{"SET", "a", large}), cmds.NewCompleted([]string{"SET", "b", large})) {
+		ExpectOK(t, resp)
+	}
+}
+
 func TestPanicOnProtocolBug(t *testing.T) {
 	p, mock, _, _ := setup(t, ConnOption{})
 
@@ -294,6 +356,154 @@ func TestPanicOnProtocolBug(t *testing.T) {
 	p._backgroundRead()
 }
 
+func TestProtocolErrorOnMalformedReply(t *testing.T) {
+	p, mock, _, closeConn := setup(t, ConnOption{})
+	defer closeConn()
+
+	go func() {
+		mock.Expect("GET", "a")
+		mock.conn.Write([]byte("$-5\r\n"))
+	}()
+
+	var pe *ProtocolError
+	if err := p.Do(cmds.NewCompleted([]string{"GET", "a"})).Error(); !errors.As(err, &pe) {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if err := p.Do(cmds.NewCompleted([]string{"GET", "a"})).Error(); !errors.As(err, &pe) {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestProtocolErrorOnMalformedPush(t *testing.T) {
+	for _, push := range []string{
+		">0\r\n",
+		">2\r\n+message\r\n+ch\r\n",
+		">3\r\n+pmessage\r\n+pat\r\n+ch\r\n",
+		">2\r\n+subscribe\r\n+ch\r\n",
+		">1\r\n+invalidate\r\n",
+	} {
+		p, mock, _, closeConn := setup(t, ConnOption{PubSubHandlers: PubSubHandlers{
+			onMessage:  func(channel, message string) {},
+			onPMessage: func(pattern, channel, message string) {},
+		}})
+		p.background() // push messages are only handled by the background worker
+		go func() {
+			mock.Expect("GET", "a")
+			mock.conn.Write([]byte(push))
+		}()
+		var pe *ProtocolError
+		if err := p.Do(cmds.NewCompleted([]string{"GET", "a"})).Error(); !errors.As(err, &pe) {
+			t.Fatalf("unexpected err %v on %q", err, push)
+		}
+		closeConn()
+	}
+}
+
+type failWriter struct{}
+
+func (w failWriter) Write(p []byte) (int, error) {
+	return 0, io.ErrShortWrite
+}
+
+func TestDoStream(t *testing.T) {
+	t.Run("Blob String", func(t *testing.T) {
+		p, mock, cancel, _ := setup(t, ConnOption{})
+		defer cancel()
+		go func() {
+			mock.Expect("GET", "a")
+			mock.conn.Write([]byte(">2\r\n+invalidate\r\n*1\r\n+b\r\n$11\r\nhello world\r\n"))
+			mock.Expect("GET", "a").ReplyString("OK")
+		}()
+		buf := &strings.Builder{}
+		if n, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), buf); err != nil || n != 11 || buf.String() != "hello world" {
+			t.Fatalf("unexpected response %v %v %v", n, err, buf.String())
+		}
+		ExpectOK(t, p.Do(cmds.NewCompleted([]string{"GET", "a"})))
+	})
+	t.Run("Streamed String", func(t *testing.T) {
+		p, mock, cancel, _ := setup(t, ConnOption{})
+		defer cancel()
+		go func() {
+			mock.Expect("GET", "a")
+			mock.conn.Write([]byte("$?\r\n;5\r\nhello\r\n;6\r\n world\r\n;0\r\n"))
+		}()
+		buf := &strings.Builder{}
+		if n, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), buf); err != nil || n != 11 || buf.String() != "hello world" {
+			t.Fatalf("unexpected response %v %v %v", n, err, buf.String())
+		}
+	})
+	t.Run("Error Reply", func(t *testing.T) {
+		p, mock, cancel, _ := setup(t, ConnOption{})
+		defer cancel()
+		go func() {
+			mock.Expect("GET", "a").Reply(proto.Message{Type: '-', String: "ERR"})
+		}()
+		if _, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), io.Discard); err == nil || err.Error() != "ERR" {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if p.Error() != nil {
+			t.Fatalf("unexpected pipe err %v", p.Error())
+		}
+	})
+	t.Run("Not Blob String", func(t *testing.T) {
+		p, mock, cancel, _ := setup(t, ConnOption{})
+		defer cancel()
+		go func() {
+			mock.Expect("GET", "a").ReplyInteger(1)
+		}()
+		if _, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), io.Discard); !errors.Is(err, ErrParse) {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+	t.Run("Writer Error", func(t *testing.T) {
+		p, mock, _, _ := setup(t, ConnOption{})
+		go func() {
+			mock.Expect("GET", "a")
+			mock.conn.Write([]byte("$5\r\nhello\r\n"))
+		}()
+		if _, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), failWriter{}); err != io.ErrShortWrite {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if err := p.Error(); err != io.ErrShortWrite {
+			t.Fatalf("unexpected pipe err %v", err)
+		}
+	})
+	t.Run("Deadline", func(t *testing.T) {
+		p, mock, _, _ := setup(t, ConnOption{})
+		go func() {
+			mock.Expect("GET", "a")
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		if _, err := p.DoStream(ctx, cmds.NewCompleted([]string{"GET", "a"}), io.Discard); !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+	t.Run("Cancel", func(t *testing.T) {
+		p, mock, _, closeConn := setup(t, ConnOption{})
+		defer closeConn()
+		go func() {
+			mock.Expect("GET", "a")
+		}()
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+		if _, err := p.DoStream(ctx, cmds.NewCompleted([]string{"GET", "a"}), io.Discard); err != context.Canceled {
+			t.Fatalf("unexpected err %v", err)
+		}
+		if _, err := p.DoStream(ctx, cmds.NewCompleted([]string{"GET", "a"}), io.Discard); err != context.Canceled {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+	t.Run("Pipelined", func(t *testing.T) {
+		p, _, cancel, _ := setup(t, ConnOption{})
+		defer cancel()
+		p.background()
+		if _, err := p.DoStream(context.Background(), cmds.NewCompleted([]string{"GET", "a"}), io.Discard); err != errStreamOnPipeline {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+}
+
 func TestResponseSequenceWithPushMessageInjected(t *testing.T) {
 	p, mock, cancel, _ := setup(t, ConnOption{})
 	defer cancel()
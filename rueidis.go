@@ -1,21 +1,40 @@
 package rueidis
 
 import (
+	"context"
 	"crypto/tls"
 	"errors"
 	"net"
+	"strings"
 	"time"
 
 	"github.com/rueian/rueidis/internal/proto"
 )
 
 const (
-	DefaultCacheBytes = 128 * (1 << 20) // 128 MiB
-	DefaultPoolSize   = 1000
+	DefaultCacheBytes    = 128 * (1 << 20) // 128 MiB
+	DefaultPoolSize      = 1000
+	MaxPipelineMultiplex = 8
 )
 
 var ErrConnClosing = errors.New("connection is closing")
 
+// ErrParse matches any *ParseError with errors.Is
+var ErrParse = proto.ErrParse
+
+// ParseError is returned when a response is converted into an unexpected type, ex. calling ToInt64 on a string reply.
+type ParseError = proto.ParseError
+
+// ProtocolError is returned when a malformed reply is received. The connection is then closed and reconnected.
+type ProtocolError = proto.ProtocolError
+
+// The types returned by the decoding helpers of the proto.Result, ex. AsZScores, AsXRange and AsGeoLocations
+type (
+	ZScore      = proto.ZScore
+	XRangeEntry = proto.XRangeEntry
+	GeoLocation = proto.GeoLocation
+)
+
 type ConnOption struct {
 	// CacheSizeEachConn is redis client side cache size that bind to each TCP connection to a single redis instance.
 	// The default is DefaultCacheBytes.
@@ -25,16 +44,47 @@ type ConnOption struct {
 	// The default is DefaultPoolSize.
 	BlockingPoolSize int
 
+	// PipelineMultiplex determines how many tcp connections used to pipeline commands to one redis instance.
+	// The number of connections is 2^PipelineMultiplex, and the default 0 means only one connection is used.
+	// Each connection has its own client side cache, and DoCache with the same key always goes to the same connection.
+	// The maximum is MaxPipelineMultiplex.
+	PipelineMultiplex int
+
+	// CheckServerVersion, if true, checks the commands against the redis version in the HELLO reply before sending them,
+	// and fails fast with a *VersionError if the command is introduced in a newer version. Module commands are not checked.
+	CheckServerVersion bool
+
 	// Redis AUTH parameters
 	Username   string
 	Password   string
 	ClientName string
 	SelectDB   int
 
+	// CredentialsFn, if provided, is called for every new connection, including pooled ones, to get the AUTH
+	// username and password, instead of using the static Username and Password. It is useful for short-lived tokens.
+	CredentialsFn func(ctx context.Context) (username, password string, err error)
+
+	// CredentialsRefresh, if positive, re-AUTHs the live auto pipelining connections with the CredentialsFn
+	// at the interval, so that they keep working after the previous token expires.
+	// The pooled connections for blocking and dedicated usage are not re-AUTHed, because they may be in a transaction.
+	CredentialsRefresh time.Duration
+
 	// TCP & TLS
 	DialTimeout time.Duration
 	TLSConfig   *tls.Config
 
+	// TLSFiles, if provided, enables mutual TLS with the certificate files, which are reloaded when they are changed.
+	// The TLSConfig, if also provided, is used as the base config.
+	TLSFiles *TLSFiles
+
+	// Dialer is used to establish connections. It can be used to set socket options with its Control func.
+	// Its Timeout defaults to DialTimeout, and its KeepAlive defaults to 1 second.
+	Dialer net.Dialer
+
+	// DialFn overrides how connections are established, ex. dialing through a SOCKS5 or HTTP CONNECT proxy.
+	// The network is "tcp" or "unix", and the tlsConfig is not nil if TLS should be applied to the connection.
+	DialFn func(network, addr string, dialer *net.Dialer, tlsConfig *tls.Config) (net.Conn, error)
+
 	// Redis PubSub callbacks
 	PubSubHandlers PubSubHandlers
 }
@@ -47,6 +97,10 @@ func NewSingleClient(option SingleClientOption) (*SingleClient, error) {
 	return newSingleClient(option, makeConn)
 }
 
+func NewShardedClient(option ShardedClientOption) (*ShardedClient, error) {
+	return newShardedClient(option, makeConn)
+}
+
 func IsRedisNil(err error) bool {
 	return proto.IsRedisNil(err)
 }
@@ -56,11 +110,41 @@ func makeConn(dst string, opt ConnOption) conn {
 }
 
 func dial(dst string, opt ConnOption) (conn net.Conn, err error) {
-	dialer := &net.Dialer{Timeout: opt.DialTimeout, KeepAlive: time.Second}
-	if opt.TLSConfig != nil {
-		conn, err = tls.DialWithDialer(dialer, "tcp", dst, opt.TLSConfig)
+	network, addr, tlsConfig := parseAddr(dst, opt.TLSConfig)
+	if opt.TLSFiles != nil && network == "tcp" {
+		if tlsConfig, err = opt.TLSFiles.load(opt.TLSConfig); err != nil {
+			return nil, err
+		}
+	}
+	dialer := opt.Dialer
+	if dialer.Timeout == 0 {
+		dialer.Timeout = opt.DialTimeout
+	}
+	if dialer.KeepAlive == 0 {
+		dialer.KeepAlive = time.Second
+	}
+	if opt.DialFn != nil {
+		return opt.DialFn(network, addr, &dialer, tlsConfig)
+	}
+	if tlsConfig != nil {
+		conn, err = tls.DialWithDialer(&dialer, network, addr, tlsConfig)
 	} else {
-		conn, err = dialer.Dial("tcp", dst)
+		conn, err = dialer.Dial(network, addr)
 	}
 	return conn, err
 }
+
+// parseAddr supports the "unix://", "redis://" and "rediss://" schemes in the dst.
+// The "rediss://" enables TLS even if the TLSConfig is not provided.
+func parseAddr(dst string, tlsConfig *tls.Config) (network, addr string, cfg *tls.Config) {
+	switch {
+	case strings.HasPrefix(dst, "unix://"):
+		return "unix", strings.TrimPrefix(dst, "unix://"), nil
+	case strings.HasPrefix(dst, "rediss://"):
+		if tlsConfig == nil {
+			tlsConfig = &tls.Config{}
+		}
+		return "tcp", strings.TrimPrefix(dst, "rediss://"), tlsConfig
+	}
+	return "tcp", strings.TrimPrefix(dst, "redis://"), tlsConfig
+}
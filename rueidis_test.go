@@ -0,0 +1,74 @@
+package rueidis
+
+import (
+	"crypto/tls"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseAddr(t *testing.T) {
+	cfg := &tls.Config{ServerName: "a"}
+	for _, c := range []struct {
+		dst     string
+		tls     *tls.Config
+		network string
+		addr    string
+		useTLS  bool
+	}{
+		{dst: "127.0.0.1:6379", network: "tcp", addr: "127.0.0.1:6379"},
+		{dst: "127.0.0.1:6379", tls: cfg, network: "tcp", addr: "127.0.0.1:6379", useTLS: true},
+		{dst: "redis://127.0.0.1:6379", network: "tcp", addr: "127.0.0.1:6379"},
+		{dst: "rediss://127.0.0.1:6379", network: "tcp", addr: "127.0.0.1:6379", useTLS: true},
+		{dst: "rediss://127.0.0.1:6379", tls: cfg, network: "tcp", addr: "127.0.0.1:6379", useTLS: true},
+		{dst: "unix:///tmp/redis.sock", tls: cfg, network: "unix", addr: "/tmp/redis.sock"},
+	} {
+		network, addr, tlsConfig := parseAddr(c.dst, c.tls)
+		if network != c.network || addr != c.addr || (tlsConfig != nil) != c.useTLS {
+			t.Fatalf("unexpected parse result of %v: %v %v %v", c.dst, network, addr, tlsConfig)
+		}
+		if c.tls != nil && tlsConfig != nil && tlsConfig != c.tls {
+			t.Fatalf("the provided TLSConfig should be used")
+		}
+	}
+}
+
+func TestDialUnix(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "redis.sock")
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	defer ln.Close()
+	go func() {
+		if c, err := ln.Accept(); err == nil {
+			c.Close()
+		}
+	}()
+	conn, err := dial("unix://"+path, ConnOption{})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	conn.Close()
+}
+
+func TestDialFn(t *testing.T) {
+	n1, n2 := net.Pipe()
+	defer n2.Close()
+	conn, err := dial("rediss://127.0.0.1:6379", ConnOption{
+		DialTimeout: time.Second,
+		DialFn: func(network, addr string, dialer *net.Dialer, tlsConfig *tls.Config) (net.Conn, error) {
+			if network != "tcp" || addr != "127.0.0.1:6379" || tlsConfig == nil {
+				t.Fatalf("unexpected dial args %v %v %v", network, addr, tlsConfig)
+			}
+			if dialer.Timeout != time.Second || dialer.KeepAlive != time.Second {
+				t.Fatalf("unexpected dialer %v", dialer)
+			}
+			return n1, nil
+		},
+	})
+	if err != nil || conn != n1 {
+		t.Fatalf("unexpected result %v %v", conn, err)
+	}
+}
@@ -0,0 +1,222 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+// maxScanRetries is the number of times a Scanner resends the command with the same cursor on network errors.
+const maxScanRetries = 3
+
+// Scanner iterates the elements returned by the SCAN, HSCAN, SSCAN or ZSCAN across their cursors.
+// It is not safe for concurrent use.
+type Scanner struct {
+	fetch func(ctx context.Context) (elements []proto.Message, more bool, err error)
+	buf   []proto.Message
+	more  bool
+	pairs bool
+	zset  bool
+	val   string
+	value string
+	score float64
+	err   error
+}
+
+func newScanner(cs []string, fetch func(ctx context.Context) ([]proto.Message, bool, error)) *Scanner {
+	s := &Scanner{fetch: fetch, more: true}
+	switch cs[0] {
+	case "HSCAN":
+		s.pairs = true
+		for _, c := range cs {
+			if c == "NOVALUES" {
+				s.pairs = false
+			}
+		}
+	case "ZSCAN":
+		s.pairs, s.zset = true, true
+	}
+	return s
+}
+
+// cursorScanner iterates the cursor of the scan from 0, and resends it with the last cursor on network errors.
+func cursorScanner(scan cmds.Completed, do func(ctx context.Context, cmd cmds.Completed) proto.Result) *Scanner {
+	var cursor int64
+	return newScanner(scan.Commands(), func(ctx context.Context) ([]proto.Message, bool, error) {
+		for retry := 0; ; retry++ {
+			resp := do(ctx, scan.WithCursor(cursor))
+			if isNetworkErr(resp.NonRedisError()) && retry < maxScanRetries && ctx.Err() == nil {
+				continue
+			}
+			next, elements, err := parseScan(resp)
+			if err != nil {
+				return nil, false, err
+			}
+			cursor = next
+			return elements, cursor != 0, nil
+		}
+	})
+}
+
+// Next advances the Scanner to the next element, fetching the next batch with the ctx if needed.
+// It returns false when the iteration is done or an error occurs, which is reported by the Err.
+func (s *Scanner) Next(ctx context.Context) bool {
+	for len(s.buf) == 0 {
+		if !s.more || s.err != nil {
+			return false
+		}
+		s.buf, s.more, s.err = s.fetch(ctx)
+	}
+	s.val = s.buf[0].String
+	if !s.pairs {
+		s.buf = s.buf[1:]
+		return true
+	}
+	if len(s.buf) < 2 {
+		s.err = errors.New("unexpected odd number of elements in the scan reply")
+		return false
+	}
+	if s.value = s.buf[1].String; s.zset {
+		if s.score, s.err = strconv.ParseFloat(s.value, 64); s.err != nil {
+			return false
+		}
+	}
+	s.buf = s.buf[2:]
+	return true
+}
+
+// Val returns the current element, which is the key of SCAN, the member of SSCAN and ZSCAN, or the field of HSCAN.
+func (s *Scanner) Val() string {
+	return s.val
+}
+
+// Value returns the value of the current field of HSCAN.
+func (s *Scanner) Value() string {
+	return s.value
+}
+
+// Score returns the score of the current member of ZSCAN.
+func (s *Scanner) Score() float64 {
+	return s.score
+}
+
+// Err returns the error stopping the iteration, if any.
+func (s *Scanner) Err() error {
+	return s.err
+}
+
+// parseScan parses the reply of the SCAN family commands into the next cursor and the elements.
+func parseScan(resp proto.Result) (cursor int64, elements []proto.Message, err error) {
+	reply, err := resp.ToArray()
+	if err != nil {
+		return 0, nil, err
+	}
+	if len(reply) != 2 {
+		return 0, nil, fmt.Errorf("unexpected scan reply of %d elements", len(reply))
+	}
+	next, err := strconv.ParseUint(reply[0].String, 10, 64)
+	if err != nil {
+		return 0, nil, err
+	}
+	return int64(next), reply[1].Values, nil
+}
+
+// Scanner returns a Scanner iterating the cmd, which is one of the SCAN, HSCAN, SSCAN and ZSCAN, from the cursor 0.
+// The cursor of the cmd is replaced for each call, and the last one is resent on network errors.
+func (c *SingleClient) Scanner(cmd cmds.Completed) *Scanner {
+	scan := cmd.WithCursor(0)
+	c.Cmd.Put(cmd.Commands())
+	return cursorScanner(scan, c.Do)
+}
+
+// Scanner is the same as the SingleClient.Scanner, except that the SCAN is delegated to the ScanAll,
+// because it has no key to route.
+func (c *ClusterClient) Scanner(cmd cmds.SCompleted) *Scanner {
+	if cmd.Slot() == cmds.InitSlot {
+		return c.ScanAll(cmd)
+	}
+	scan := cmd.WithCursor(0)
+	c.Cmd.Put(cmd.Commands())
+	return cursorScanner(cmds.Completed(scan), func(ctx context.Context, cmd cmds.Completed) proto.Result {
+		return c.Do(ctx, cmds.SCompleted(cmd))
+	})
+}
+
+// ScanAll returns a Scanner iterating the keys of every master one after another with the SCAN cmd,
+// whose cursor is replaced for each call. The masters are identified by the slots they serve when the iteration starts.
+// Because SCAN cursors are local to a node, a master replaced by failover is scanned again from the cursor 0 on the new
+// master, so the keys already returned from it are returned again. A master whose slots are migrated to an already
+// scanned one is skipped. Like the SCAN, a key may be returned more than once.
+func (c *ClusterClient) ScanAll(cmd cmds.SCompleted) *Scanner {
+	scan := cmd.WithCursor(0)
+	c.Cmd.Put(cmd.Commands())
+	var slots []uint16 // the first slot of each master
+	var scanned map[conn]bool
+	var current conn // the conn which the cursor belongs to
+	var cursor int64
+	return newScanner(scan.Commands(), func(ctx context.Context) ([]proto.Message, bool, error) {
+		if !c.busy.enter() {
+			return nil, false, ErrConnClosing
+		}
+		defer c.busy.leave()
+		if scanned == nil {
+			scanned = make(map[conn]bool)
+			slots = c.masterSlots()
+		}
+		retried := false
+		for len(slots) != 0 {
+			if err := ctx.Err(); err != nil {
+				return nil, false, err
+			}
+			cc, err := c.pick(slots[0])
+			if err != nil {
+				return nil, false, err
+			}
+			if cursor != 0 && cc != current {
+				// the slot is failed over to another master, where the cursor is meaningless
+				cursor = 0
+			}
+			if cursor == 0 && scanned[cc] {
+				slots = slots[1:]
+				continue
+			}
+			current = cc
+			resp := cc.Do(cmds.Completed(scan.WithCursor(cursor)))
+			if err = resp.NonRedisError(); isNetworkErr(err) && !retried {
+				// the slot may have been failed over to another master, which is then scanned from the start
+				retried = true
+				if err = c.refresh(); err != nil {
+					return nil, false, err
+				}
+				continue
+			}
+			next, elements, err := parseScan(resp)
+			if err != nil {
+				return nil, false, err
+			}
+			if cursor = next; cursor == 0 {
+				scanned[cc] = true
+				slots = slots[1:]
+			}
+			return elements, len(slots) != 0, nil
+		}
+		return nil, false, nil
+	})
+}
+
+func (c *ClusterClient) masterSlots() (slots []uint16) {
+	seen := make(map[conn]bool)
+	c.mu.RLock()
+	for i, cc := range c.slots {
+		if cc != nil && !seen[cc] {
+			seen[cc] = true
+			slots = append(slots, uint16(i))
+		}
+	}
+	c.mu.RUnlock()
+	return slots
+}
@@ -0,0 +1,211 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strconv"
+	"sync/atomic"
+	"testing"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func scanResp(cursor string, elements ...string) proto.Result {
+	values := make([]proto.Message, len(elements))
+	for i, e := range elements {
+		values[i] = proto.Message{Type: '+', String: e}
+	}
+	return proto.NewResult(proto.Message{Type: '*', Values: []proto.Message{
+		{Type: '+', String: cursor},
+		{Type: '*', Values: values},
+	}}, nil)
+}
+
+func TestClusterClientScanAll(t *testing.T) {
+	var failover int64
+	keys := map[string]map[string]proto.Result{
+		"127.0.0.1:1": {"0": scanResp("5", "a", "b"), "5": scanResp("0", "c")},
+		"127.0.0.1:2": {"0": scanResp("0", "d")},
+		"127.0.0.1:3": {"0": scanResp("0", "a", "b", "c")},
+	}
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:1"}}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] == "CLUSTER" {
+				if atomic.LoadInt64(&failover) == 1 {
+					return slotsOf([]interface{}{0, 8191, 3}, []interface{}{8192, 16383, 2})
+				}
+				return slotsOf([]interface{}{0, 8191, 1}, []interface{}{8192, 16383, 2})
+			}
+			if dst == "127.0.0.1:1" && atomic.LoadInt64(&failover) == 1 {
+				return proto.NewErrResult(errors.New("network err"))
+			}
+			return keys[dst][cmd.Commands()[1]]
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+
+	t.Run("ScanAll", func(t *testing.T) {
+		var got []string
+		s := client.ScanAll(client.Cmd.Scan().Cursor(0).Count(10).Build())
+		for s.Next(context.Background()) {
+			got = append(got, s.Val())
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+			t.Fatalf("unexpected keys %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("ScanAll across failover", func(t *testing.T) {
+		var got []string
+		s := client.ScanAll(client.Cmd.Scan().Cursor(0).Count(10).Build())
+		for s.Next(context.Background()) {
+			if got = append(got, s.Val()); len(got) == 2 {
+				atomic.StoreInt64(&failover, 1)
+			}
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"a", "b", "a", "b", "c", "d"}) {
+			t.Fatalf("unexpected keys %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("ScanAll ctx done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		s := client.ScanAll(client.Cmd.Scan().Cursor(0).Count(10).Build())
+		if s.Next(ctx) || s.Err() != context.Canceled {
+			t.Fatalf("unexpected err %v", s.Err())
+		}
+	})
+}
+
+func TestParseScan(t *testing.T) {
+	if _, _, err := parseScan(proto.NewResult(proto.Message{Type: '*'}, nil)); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	if _, _, err := parseScan(scanResp("x")); !errors.Is(err, strconv.ErrSyntax) {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if c, e, err := parseScan(scanResp("18446744073709551615", "a")); err != nil || c != -1 || len(e) != 1 {
+		t.Fatalf("unexpected result %v %v %v", c, e, err)
+	}
+}
+
+func TestSingleClientScanner(t *testing.T) {
+	var fails int64
+	replies := map[string]map[string]proto.Result{
+		"SCAN":  {"0": scanResp("3", "a"), "3": scanResp("0", "b")},
+		"SSCAN": {"0": scanResp("0", "m1", "m2")},
+		"HSCAN": {"0": scanResp("7", "f1", "v1"), "7": scanResp("0", "f2", "v2")},
+		"ZSCAN": {"0": scanResp("0", "m1", "1.5", "m2", "inf")},
+	}
+	client, err := newSingleClient(SingleClientOption{Address: ""}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			cs := cmd.Commands()
+			if cs[0] == "SCAN" {
+				if cs[1] == "3" && atomic.AddInt64(&fails, 1) <= 2 {
+					return proto.NewErrResult(errors.New("network err"))
+				}
+				return replies["SCAN"][cs[1]]
+			}
+			if cs[1] == "odd" {
+				return scanResp("0", "f1")
+			}
+			return replies[cs[0]][cs[2]]
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("SCAN retry", func(t *testing.T) {
+		var got []string
+		s := client.Scanner(client.Cmd.Scan().Cursor(0).Match("*").Build())
+		for s.Next(ctx) {
+			got = append(got, s.Val())
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"a", "b"}) {
+			t.Fatalf("unexpected keys %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("SSCAN", func(t *testing.T) {
+		var got []string
+		s := client.Scanner(client.Cmd.Sscan().Key("k").Cursor(0).Build())
+		for s.Next(ctx) {
+			got = append(got, s.Val())
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"m1", "m2"}) {
+			t.Fatalf("unexpected members %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("HSCAN", func(t *testing.T) {
+		var got []string
+		s := client.Scanner(client.Cmd.Hscan().Key("k").Cursor(0).Count(10).Build())
+		for s.Next(ctx) {
+			got = append(got, s.Val()+"="+s.Value())
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"f1=v1", "f2=v2"}) {
+			t.Fatalf("unexpected fields %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("ZSCAN", func(t *testing.T) {
+		var got []string
+		s := client.Scanner(client.Cmd.Zscan().Key("k").Cursor(0).Build())
+		for s.Next(ctx) {
+			got = append(got, s.Val()+"="+strconv.FormatFloat(s.Score(), 'f', -1, 64))
+		}
+		if s.Err() != nil || !reflect.DeepEqual(got, []string{"m1=1.5", "m2=+Inf"}) {
+			t.Fatalf("unexpected members %v %v", got, s.Err())
+		}
+	})
+
+	t.Run("Odd pairs", func(t *testing.T) {
+		s := client.Scanner(client.Cmd.Hscan().Key("odd").Cursor(0).Build())
+		if s.Next(ctx) || s.Err() == nil {
+			t.Fatalf("unexpected err %v", s.Err())
+		}
+	})
+
+	t.Run("Network error", func(t *testing.T) {
+		atomic.StoreInt64(&fails, -100)
+		s := client.Scanner(client.Cmd.Scan().Cursor(0).Build())
+		for s.Next(ctx) {
+		}
+		if s.Err() == nil || s.Err().Error() != "network err" {
+			t.Fatalf("unexpected err %v", s.Err())
+		}
+	})
+}
+
+func TestClusterClientScanner(t *testing.T) {
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			cs := cmd.Commands()
+			switch cs[0] {
+			case "CLUSTER":
+				return slotsResp
+			case "SCAN":
+				return scanResp("0", "a")
+			}
+			return scanResp("0", "f1", "v1")
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	s := client.Scanner(client.Cmd.Hscan().Key("k").Cursor(0).Build())
+	if !s.Next(context.Background()) || s.Val() != "f1" || s.Value() != "v1" || s.Next(context.Background()) || s.Err() != nil {
+		t.Fatalf("unexpected scanner %v %v %v", s.Val(), s.Value(), s.Err())
+	}
+	s = client.Scanner(client.Cmd.Scan().Cursor(0).Build())
+	if !s.Next(context.Background()) || s.Val() != "a" || s.Next(context.Background()) || s.Err() != nil {
+		t.Fatalf("unexpected scanner %v %v", s.Val(), s.Err())
+	}
+}
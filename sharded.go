@@ -0,0 +1,455 @@
+package rueidis
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/binary"
+	"errors"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+	"github.com/rueian/rueidis/om"
+)
+
+const (
+	// DefaultVirtualNodes is the default of the ShardedClientOption.VirtualNodes.
+	DefaultVirtualNodes = 160
+	// DefaultHealthCheckFailures is the default of the ShardedClientOption.HealthCheckFailures.
+	DefaultHealthCheckFailures = 3
+)
+
+var ErrNoShards = errors.New("no shard address provided")
+
+type ShardedClientOption struct {
+	// Addresses are the standalone redis instances, each of which is a shard identified by its address on the hash ring.
+	Addresses  []string
+	ConnOption ConnOption
+
+	// VirtualNodes is the number of points of each shard on the hash ring. The default is DefaultVirtualNodes.
+	VirtualNodes int
+
+	// HealthCheckInterval, if positive, PINGs every shard at the interval. A shard failing HealthCheckFailures
+	// consecutive checks is ejected from the hash ring, and its keys are rebalanced to the other shards until it
+	// answers again. Shards unreachable at start are ejected as well, instead of failing the NewShardedClient.
+	HealthCheckInterval time.Duration
+	// HealthCheckFailures is the number of consecutive failed checks to eject a shard. The default is DefaultHealthCheckFailures.
+	HealthCheckFailures int
+}
+
+// ShardedClient routes commands across independent redis instances, which are not in cluster mode,
+// by consistent hashing the slots of their keys onto a ring with virtual nodes. Keys of the same hash tag,
+// and therefore the keys of a multi-key command, always go to the same shard.
+type ShardedClient struct {
+	Cmd *cmds.SBuilder
+	opt ShardedClientOption
+
+	mu     sync.RWMutex
+	slots  [16384]*shard
+	shards []*shard
+	busy   *inflight
+}
+
+// Shard is a standalone redis instance of the ShardedClient.
+type Shard struct {
+	Addr string
+	// Ejected is true if the shard failed the health checks and is removed from the hash ring.
+	Ejected bool
+}
+
+type shard struct {
+	addr string
+	conn conn
+	// fails is only accessed by the health check.
+	fails   int
+	ejected bool
+}
+
+func newShardedClient(opt ShardedClientOption, connFn connFn) (client *ShardedClient, err error) {
+	if len(opt.Addresses) == 0 {
+		return nil, ErrNoShards
+	}
+	if opt.VirtualNodes <= 0 {
+		opt.VirtualNodes = DefaultVirtualNodes
+	}
+	if opt.HealthCheckFailures <= 0 {
+		opt.HealthCheckFailures = DefaultHealthCheckFailures
+	}
+
+	client = &ShardedClient{Cmd: cmds.NewSBuilder(), opt: opt, busy: newInflight()}
+
+	seen := make(map[string]bool, len(opt.Addresses))
+	healthy := 0
+	for _, addr := range opt.Addresses {
+		if seen[addr] {
+			continue
+		}
+		seen[addr] = true
+		s := &shard{addr: addr, conn: connFn(addr, opt.ConnOption)}
+		client.shards = append(client.shards, s)
+		if e := s.conn.Dial(); e != nil {
+			if err, s.ejected = e, true; opt.HealthCheckInterval <= 0 {
+				break
+			}
+		} else {
+			healthy++
+		}
+	}
+	if healthy == 0 || opt.HealthCheckInterval <= 0 && err != nil {
+		for _, s := range client.shards {
+			s.conn.Close()
+		}
+		return nil, err
+	}
+	client.rebalance()
+
+	if opt.HealthCheckInterval > 0 {
+		go client.checkPeriodically(opt.HealthCheckInterval)
+	}
+
+	return client, nil
+}
+
+// rebalance rebuilds the slot table from the hash ring of the shards not ejected. The c.mu should be held if the client is in use.
+// If all shards are ejected, the keys are distributed over all of them as if none is ejected.
+func (c *ShardedClient) rebalance() {
+	type point struct {
+		hash  uint32
+		shard *shard
+	}
+	points := make([]point, 0, len(c.shards)*c.opt.VirtualNodes)
+	add := func(s *shard) {
+		for i := 0; i < c.opt.VirtualNodes; i++ {
+			points = append(points, point{hash: ringHash(s.addr + "-" + strconv.Itoa(i)), shard: s})
+		}
+	}
+	for _, s := range c.shards {
+		if !s.ejected {
+			add(s)
+		}
+	}
+	if len(points) == 0 {
+		for _, s := range c.shards {
+			add(s)
+		}
+	}
+	sort.Slice(points, func(i, j int) bool { return points[i].hash < points[j].hash })
+	for slot := range c.slots {
+		h := ringHash(strconv.Itoa(slot))
+		i := sort.Search(len(points), func(i int) bool { return points[i].hash >= h })
+		if i == len(points) {
+			i = 0
+		}
+		c.slots[slot] = points[i].shard
+	}
+}
+
+func ringHash(s string) uint32 {
+	sum := md5.Sum([]byte(s))
+	return binary.LittleEndian.Uint32(sum[:4])
+}
+
+// checkPeriodically runs the health checks at the interval until the client is closed.
+func (c *ShardedClient) checkPeriodically(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !c.busy.enter() {
+				return
+			}
+			c.check()
+			c.busy.leave()
+		case <-c.busy.done:
+			return
+		}
+	}
+}
+
+// check PINGs all shards concurrently, then ejects the ones failing too many times and restores the ones answering again.
+func (c *ShardedClient) check() {
+	errs := make([]error, len(c.shards))
+	wg := sync.WaitGroup{}
+	wg.Add(len(c.shards))
+	for i, s := range c.shards {
+		go func(i int, cc conn) {
+			if errs[i] = cc.Dial(); errs[i] == nil {
+				errs[i] = cc.Do(cmds.PingCmd).Error()
+			}
+			wg.Done()
+		}(i, s.conn)
+	}
+	wg.Wait()
+
+	c.mu.Lock()
+	changed := false
+	for i, s := range c.shards {
+		if errs[i] == nil {
+			s.fails = 0
+			if s.ejected {
+				s.ejected, changed = false, true
+			}
+		} else if s.fails++; s.fails >= c.opt.HealthCheckFailures && !s.ejected {
+			s.ejected, changed = true, true
+		}
+	}
+	if changed {
+		c.rebalance()
+	}
+	c.mu.Unlock()
+}
+
+// Shards returns the shards in the order of the ShardedClientOption.Addresses.
+func (c *ShardedClient) Shards() []Shard {
+	c.mu.RLock()
+	shards := make([]Shard, len(c.shards))
+	for i, s := range c.shards {
+		shards[i] = Shard{Addr: s.addr, Ejected: s.ejected}
+	}
+	c.mu.RUnlock()
+	return shards
+}
+
+// ShardOf returns the address of the shard serving the key currently.
+func (c *ShardedClient) ShardOf(key string) string {
+	s, _ := c.pick(cmds.Slot(key))
+	return s.addr
+}
+
+// pick returns the shard of the slot. Commands without keys go to the first shard not ejected,
+// while the ones built by the Cmd.Split() with keys in different slots are rejected with the ErrMultiSlot.
+func (c *ShardedClient) pick(slot uint16) (s *shard, err error) {
+	if slot == cmds.MultiSlot {
+		return nil, ErrMultiSlot
+	}
+	c.mu.RLock()
+	if slot == cmds.InitSlot {
+		s = c.shards[0]
+		for _, v := range c.shards {
+			if !v.ejected {
+				s = v
+				break
+			}
+		}
+	} else {
+		s = c.slots[slot]
+	}
+	c.mu.RUnlock()
+	return s, nil
+}
+
+// Do sends the cmd to the shard serving its keys. Commands without keys, such as the FLUSHALL or SCRIPT LOAD,
+// only go to the first shard not ejected, and the Broadcast or ForEachShard should be used to reach every shard.
+func (c *ShardedClient) Do(ctx context.Context, cmd cmds.SCompleted) (resp proto.Result) {
+	if !c.busy.enter() {
+		c.Cmd.Put(cmd.Commands())
+		return proto.NewErrResult(ErrConnClosing)
+	}
+	defer c.busy.leave()
+	if cmd.Slot() == cmds.MultiSlot {
+		resp = doSplit(cmd, func(part cmds.SCompleted) proto.Result { return c.Do(ctx, part) })
+	} else if s, err := c.pick(cmd.Slot()); err != nil {
+		resp = proto.NewErrResult(err)
+	} else {
+		resp = s.conn.Do(cmds.Completed(cmd))
+	}
+	c.Cmd.Put(cmd.Commands())
+	return resp
+}
+
+// DoCache caches the reply in the connection of the shard serving the key, so the cached keys of an ejected shard
+// are served by another shard, and cached there, until it is restored.
+func (c *ShardedClient) DoCache(ctx context.Context, cmd cmds.SCacheable, ttl time.Duration) (resp proto.Result) {
+	if c.busy.enter() {
+		if s, err := c.pick(cmd.Slot()); err != nil {
+			resp = proto.NewErrResult(err)
+		} else {
+			resp = s.conn.DoCache(cmds.Cacheable(cmd), ttl)
+		}
+		c.busy.leave()
+	} else {
+		resp = proto.NewErrResult(ErrConnClosing)
+	}
+	c.Cmd.Put(cmd.Commands())
+	return resp
+}
+
+// DoStream is the same as the SingleClient.DoStream, and it uses the blocking pool of the shard serving the key.
+func (c *ShardedClient) DoStream(ctx context.Context, cmd cmds.SCompleted) ResultStream {
+	return ResultStream{fn: func(w io.Writer) (int64, error) {
+		if !c.busy.enter() {
+			return 0, ErrConnClosing
+		}
+		defer c.busy.leave()
+		s, err := c.pick(cmd.Slot())
+		if err != nil {
+			return 0, err
+		}
+		return s.conn.DoStream(ctx, cmds.Completed(cmd), w)
+	}}
+}
+
+// ShardClient sends commands to a single shard regardless of the slots of their keys.
+// It is only valid in the ForEachShard callback.
+type ShardClient struct {
+	Cmd   *cmds.Builder
+	Shard Shard
+	conn  conn
+}
+
+func (s *ShardClient) Do(ctx context.Context, cmd cmds.Completed) (resp proto.Result) {
+	resp = s.conn.Do(cmd)
+	s.Cmd.Put(cmd.Commands())
+	return resp
+}
+
+func (s *ShardClient) DoMulti(ctx context.Context, multi ...cmds.Completed) (resp []proto.Result) {
+	resp = s.conn.DoMulti(multi...)
+	for _, cmd := range multi {
+		s.Cmd.Put(cmd.Commands())
+	}
+	return resp
+}
+
+// ForEachShard calls the fn concurrently with a ShardClient for each shard not ejected,
+// and returns the first error in the order of the ShardedClientOption.Addresses.
+func (c *ShardedClient) ForEachShard(ctx context.Context, fn func(ctx context.Context, shard *ShardClient) error) error {
+	if !c.busy.enter() {
+		return ErrConnClosing
+	}
+	defer c.busy.leave()
+
+	var shards []*ShardClient
+	c.mu.RLock()
+	for _, s := range c.shards {
+		if !s.ejected {
+			shards = append(shards, &ShardClient{Cmd: (*cmds.Builder)(c.Cmd), Shard: Shard{Addr: s.addr}, conn: s.conn})
+		}
+	}
+	c.mu.RUnlock()
+
+	errs := make([]error, len(shards))
+	wg := sync.WaitGroup{}
+	wg.Add(len(shards))
+	for i, s := range shards {
+		go func(i int, s *ShardClient) {
+			errs[i] = fn(ctx, s)
+			wg.Done()
+		}(i, s)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Broadcast sends the cmd to every shard not ejected concurrently, such as the FLUSHALL, SCRIPT LOAD or CONFIG SET,
+// and returns their results keyed by the shard addresses.
+func (c *ShardedClient) Broadcast(ctx context.Context, cmd cmds.SCompleted) (map[string]proto.Result, error) {
+	mu := sync.Mutex{}
+	results := make(map[string]proto.Result)
+	err := c.ForEachShard(ctx, func(ctx context.Context, shard *ShardClient) error {
+		resp := shard.conn.Do(cmds.Completed(cmd))
+		mu.Lock()
+		results[shard.Shard.Addr] = resp
+		mu.Unlock()
+		return nil
+	})
+	c.Cmd.Put(cmd.Commands())
+	return results, err
+}
+
+func (c *ShardedClient) NewLuaScript(body string) *Lua {
+	return newLuaScript(body, c.eval, c.evalSha)
+}
+
+func (c *ShardedClient) NewLuaScriptReadOnly(body string) *Lua {
+	return newLuaScript(body, c.evalRo, c.evalShaRo)
+}
+
+func (c *ShardedClient) eval(ctx context.Context, body string, keys, args []string) proto.Result {
+	return c.Do(ctx, c.Cmd.Eval().Script(body).Numkeys(int64(len(keys))).Key(keys...).Arg(args...).Build())
+}
+
+func (c *ShardedClient) evalSha(ctx context.Context, sha string, keys, args []string) proto.Result {
+	return c.Do(ctx, c.Cmd.Evalsha().Sha1(sha).Numkeys(int64(len(keys))).Key(keys...).Arg(args...).Build())
+}
+
+func (c *ShardedClient) evalRo(ctx context.Context, body string, keys, args []string) proto.Result {
+	return c.Do(ctx, c.Cmd.EvalRo().Script(body).Numkeys(int64(len(keys))).Key(keys...).Arg(args...).Build())
+}
+
+func (c *ShardedClient) evalShaRo(ctx context.Context, sha string, keys, args []string) proto.Result {
+	return c.Do(ctx, c.Cmd.EvalshaRo().Sha1(sha).Numkeys(int64(len(keys))).Key(keys...).Arg(args...).Build())
+}
+
+func (c *ShardedClient) NewHashRepository(prefix string, schema interface{}) *om.HashRepository {
+	return om.NewHashRepository(prefix, schema, &hashObjectShardedClientAdapter{c: c}, func(script string) om.ExecFn {
+		return c.NewLuaScript(script).Exec
+	})
+}
+
+// Close stops accepting new commands and waits for the in-flight commands to be answered.
+// Then it closes the connections to all shards concurrently, including their blocking pools, and stops the health checks.
+// If the ctx is done before the in-flight commands are answered or the connections are closed,
+// a *CloseError reporting the number of aborted commands is returned and the connections are closed in the background.
+func (c *ShardedClient) Close(ctx context.Context) error {
+	err := c.busy.drain(ctx)
+
+	wg := sync.WaitGroup{}
+	wg.Add(len(c.shards))
+	for _, s := range c.shards {
+		go func(cc conn) {
+			cc.Close()
+			wg.Done()
+		}(s.conn)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return &CloseError{Err: ctx.Err()}
+	}
+}
+
+type hashObjectShardedClientAdapter struct {
+	c *ShardedClient
+}
+
+func (h *hashObjectShardedClientAdapter) Save(ctx context.Context, key string, fields map[string]string) error {
+	cmd := h.c.Cmd.Hset().Key(key).FieldValue()
+	for f, v := range fields {
+		cmd = cmd.FieldValue(f, v)
+	}
+	return h.c.Do(ctx, cmd.Build()).Error()
+}
+
+func (h *hashObjectShardedClientAdapter) Fetch(ctx context.Context, key string) (map[string]proto.Message, error) {
+	return h.c.Do(ctx, h.c.Cmd.Hgetall().Key(key).Build()).ToMap()
+}
+
+func (h *hashObjectShardedClientAdapter) FetchCache(ctx context.Context, key string, ttl time.Duration) (map[string]proto.Message, error) {
+	return h.c.DoCache(ctx, h.c.Cmd.Hgetall().Key(key).Cache(), ttl).ToMap()
+}
+
+func (h *hashObjectShardedClientAdapter) Remove(ctx context.Context, key string) error {
+	return h.c.Do(ctx, h.c.Cmd.Del().Key(key).Build()).Error()
+}
@@ -0,0 +1,366 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func shardedConns(addrs ...string) (map[string]*MockConn, connFn) {
+	conns := make(map[string]*MockConn, len(addrs))
+	for _, addr := range addrs {
+		addr := addr
+		conns[addr] = &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			return proto.NewResult(proto.Message{Type: '+', String: addr}, nil)
+		}}
+	}
+	return conns, func(dst string, opt ConnOption) conn {
+		return conns[dst]
+	}
+}
+
+func TestNewShardedClient(t *testing.T) {
+	t.Run("no addresses", func(t *testing.T) {
+		if _, err := newShardedClient(ShardedClientOption{}, nil); err != ErrNoShards {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
+	t.Run("dial error", func(t *testing.T) {
+		closed := 0
+		v := errors.New("dial err")
+		conns, connFn := shardedConns(":1", ":2")
+		for _, m := range conns {
+			m.CloseFn = func() { closed++ }
+		}
+		conns[":2"].DialFn = func() error { return v }
+		if _, err := newShardedClient(ShardedClientOption{Addresses: []string{":1", ":2"}}, connFn); err != v || closed != 2 {
+			t.Fatalf("unexpected err %v %v", err, closed)
+		}
+	})
+
+	t.Run("dial error with health check", func(t *testing.T) {
+		v := errors.New("dial err")
+		conns, connFn := shardedConns(":1", ":2")
+		conns[":2"].DialFn = func() error { return v }
+		client, err := newShardedClient(ShardedClientOption{Addresses: []string{":1", ":2"}, HealthCheckInterval: time.Hour}, connFn)
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		defer client.Close(context.Background())
+		if shards := client.Shards(); len(shards) != 2 || shards[0].Ejected || !shards[1].Ejected {
+			t.Fatalf("unexpected shards %v", shards)
+		}
+		for i := 0; i < 100; i++ {
+			if addr := client.ShardOf(strconv.Itoa(i)); addr != ":1" {
+				t.Fatalf("unexpected shard %v", addr)
+			}
+		}
+		conns[":1"].DialFn = func() error { return v }
+		if _, err := newShardedClient(ShardedClientOption{Addresses: []string{":1", ":2"}, HealthCheckInterval: time.Hour}, connFn); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+}
+
+func TestShardedClient(t *testing.T) {
+	addrs := []string{":1", ":2", ":3"}
+	conns, connFn := shardedConns(addrs...)
+	client, err := newShardedClient(ShardedClientOption{Addresses: append(addrs, ":1")}, connFn)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	defer client.Close(context.Background())
+
+	t.Run("Shards", func(t *testing.T) {
+		if shards := client.Shards(); len(shards) != 3 || shards[0] != (Shard{Addr: ":1"}) || shards[2] != (Shard{Addr: ":3"}) {
+			t.Fatalf("unexpected shards %v", shards)
+		}
+	})
+
+	t.Run("Distribution", func(t *testing.T) {
+		counts := map[string]int{}
+		for i := 0; i < 3000; i++ {
+			counts[client.ShardOf("key"+strconv.Itoa(i))]++
+		}
+		for _, addr := range addrs {
+			if counts[addr] < 600 {
+				t.Fatalf("unexpected distribution %v", counts)
+			}
+		}
+	})
+
+	t.Run("Delegate Do", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			key := "key" + strconv.Itoa(i)
+			if v, err := client.Do(context.Background(), client.Cmd.Get().Key(key).Build()).ToString(); err != nil || v != client.ShardOf(key) {
+				t.Fatalf("unexpected response %v %v", v, err)
+			}
+		}
+	})
+
+	t.Run("Delegate Do Hash Tag", func(t *testing.T) {
+		if v, err := client.Do(context.Background(), client.Cmd.Mget().Key("{a}1", "{a}2").Build()).ToString(); err != nil || v != client.ShardOf("a") {
+			t.Fatalf("unexpected response %v %v", v, err)
+		}
+	})
+
+	t.Run("Delegate Do Without Key", func(t *testing.T) {
+		if v, err := client.Do(context.Background(), client.Cmd.Ping().Build()).ToString(); err != nil || v != ":1" {
+			t.Fatalf("unexpected response %v %v", v, err)
+		}
+	})
+
+	t.Run("Delegate Do Split", func(t *testing.T) {
+		for _, addr := range addrs {
+			conns[addr].DoFn = func(cmd cmds.Completed) proto.Result {
+				return proto.NewResult(proto.Message{Type: ':', Integer: int64(len(cmd.Commands()) - 1)}, nil)
+			}
+		}
+		defer func() {
+			for _, addr := range addrs {
+				addr := addr
+				conns[addr].DoFn = func(cmd cmds.Completed) proto.Result {
+					return proto.NewResult(proto.Message{Type: '+', String: addr}, nil)
+				}
+			}
+		}()
+		if v, err := client.Do(context.Background(), client.Cmd.Split().Del().Key("a", "b", "c", "d").Build()).ToInt64(); err != nil || v != 4 {
+			t.Fatalf("unexpected response %v %v", v, err)
+		}
+	})
+
+	t.Run("Delegate DoCache", func(t *testing.T) {
+		for _, addr := range addrs {
+			addr := addr
+			conns[addr].DoCacheFn = func(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+				if ttl != 100 {
+					return proto.NewErrResult(errors.New("unexpected ttl"))
+				}
+				return proto.NewResult(proto.Message{Type: '+', String: addr}, nil)
+			}
+		}
+		for i := 0; i < 100; i++ {
+			key := "key" + strconv.Itoa(i)
+			if v, err := client.DoCache(context.Background(), client.Cmd.Get().Key(key).Cache(), 100).ToString(); err != nil || v != client.ShardOf(key) {
+				t.Fatalf("unexpected response %v %v", v, err)
+			}
+		}
+	})
+
+	t.Run("Delegate DoStream", func(t *testing.T) {
+		conns[client.ShardOf("k")].DoStreamFn = func(ctx context.Context, cmd cmds.Completed, w io.Writer) (int64, error) {
+			n, err := w.Write([]byte("v"))
+			return int64(n), err
+		}
+		sb := strings.Builder{}
+		if n, err := client.DoStream(context.Background(), client.Cmd.Get().Key("k").Build()).WriteTo(&sb); err != nil || n != 1 || sb.String() != "v" {
+			t.Fatalf("unexpected response %v %v %v", n, err, sb.String())
+		}
+	})
+
+	t.Run("DoCache Split", func(t *testing.T) {
+		cmd := cmds.SCacheable(client.Cmd.Split().Mget().Key("k1", "k2", "k3").Build())
+		if err := client.DoCache(context.Background(), cmd, 100).Error(); err != ErrMultiSlot {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
+	t.Run("DoStream Split", func(t *testing.T) {
+		if _, err := client.DoStream(context.Background(), client.Cmd.Split().Mget().Key("k1", "k2", "k3").Build()).WriteTo(&strings.Builder{}); err != ErrMultiSlot {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
+	t.Run("Broadcast", func(t *testing.T) {
+		results, err := client.Broadcast(context.Background(), client.Cmd.Flushall().Build())
+		if err != nil || len(results) != len(addrs) {
+			t.Fatalf("unexpected response %v %v", results, err)
+		}
+		for _, addr := range addrs {
+			if v, err := results[addr].ToString(); err != nil || v != addr {
+				t.Fatalf("unexpected response of %v %v %v", addr, v, err)
+			}
+		}
+	})
+
+	t.Run("ForEachShard", func(t *testing.T) {
+		mu := sync.Mutex{}
+		seen := map[string]string{}
+		err := client.ForEachShard(context.Background(), func(ctx context.Context, shard *ShardClient) error {
+			v, err := shard.Do(ctx, shard.Cmd.Dbsize().Build()).ToString()
+			mu.Lock()
+			seen[shard.Shard.Addr] = v
+			mu.Unlock()
+			return err
+		})
+		if err != nil || len(seen) != len(addrs) {
+			t.Fatalf("unexpected response %v %v", seen, err)
+		}
+		for _, addr := range addrs {
+			if seen[addr] != addr {
+				t.Fatalf("unexpected response %v", seen)
+			}
+		}
+		v := errors.New("shard err")
+		if err := client.ForEachShard(context.Background(), func(ctx context.Context, shard *ShardClient) error {
+			if shard.Shard.Addr == ":2" {
+				return v
+			}
+			return nil
+		}); err != v {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+
+	t.Run("Lua", func(t *testing.T) {
+		key := "key0"
+		conns[client.ShardOf(key)].DoFn = func(cmd cmds.Completed) proto.Result {
+			if cmd.Commands()[0] != "EVALSHA" || cmd.Commands()[3] != key {
+				return proto.NewResult(proto.Message{Type: '-', String: "wrong command"}, nil)
+			}
+			return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+		}
+		if v, err := client.NewLuaScript("return 1").Exec(context.Background(), []string{key}, nil).ToString(); err != nil || v != "OK" {
+			t.Fatalf("unexpected response %v %v", v, err)
+		}
+	})
+}
+
+func TestShardedClientEjection(t *testing.T) {
+	addrs := []string{":1", ":2", ":3"}
+	conns, connFn := shardedConns(addrs...)
+	client, err := newShardedClient(ShardedClientOption{Addresses: addrs, HealthCheckFailures: 2}, connFn)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	defer client.Close(context.Background())
+
+	before := map[string]string{}
+	for i := 0; i < 1000; i++ {
+		key := "key" + strconv.Itoa(i)
+		before[key] = client.ShardOf(key)
+	}
+
+	mu := sync.Mutex{}
+	down := errors.New("down")
+	conns[":2"].DialFn = func() error {
+		mu.Lock()
+		defer mu.Unlock()
+		return down
+	}
+
+	client.check()
+	if shards := client.Shards(); shards[1].Ejected {
+		t.Fatalf("unexpected ejection before the failures reached %v", shards)
+	}
+	client.check()
+	if shards := client.Shards(); !shards[1].Ejected || shards[0].Ejected || shards[2].Ejected {
+		t.Fatalf("unexpected shards %v", shards)
+	}
+	for key, addr := range before {
+		if now := client.ShardOf(key); now == ":2" || (addr != ":2" && now != addr) {
+			t.Fatalf("unexpected rebalance of %v from %v to %v", key, addr, now)
+		}
+	}
+	if results, err := client.Broadcast(context.Background(), client.Cmd.Flushall().Build()); err != nil || len(results) != 2 {
+		t.Fatalf("unexpected broadcast to the ejected shard %v %v", results, err)
+	} else if _, ok := results[":2"]; ok {
+		t.Fatalf("unexpected broadcast to the ejected shard %v", results)
+	}
+
+	mu.Lock()
+	down = nil
+	mu.Unlock()
+	client.check()
+	if shards := client.Shards(); shards[1].Ejected {
+		t.Fatalf("unexpected shards %v", shards)
+	}
+	for key, addr := range before {
+		if now := client.ShardOf(key); now != addr {
+			t.Fatalf("unexpected restore of %v from %v to %v", key, addr, now)
+		}
+	}
+}
+
+func TestShardedClientHealthCheckPeriodically(t *testing.T) {
+	conns, connFn := shardedConns(":1", ":2")
+	pings := make(chan struct{}, 10)
+	conns[":2"].DoFn = func(cmd cmds.Completed) proto.Result {
+		if cmd.Commands()[0] == "PING" {
+			pings <- struct{}{}
+			return proto.NewErrResult(errors.New("timeout"))
+		}
+		return proto.Result{}
+	}
+	client, err := newShardedClient(ShardedClientOption{Addresses: []string{":1", ":2"}, HealthCheckInterval: time.Millisecond, HealthCheckFailures: 1}, connFn)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	<-pings
+	for !client.Shards()[1].Ejected {
+		time.Sleep(time.Millisecond)
+	}
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if err := client.Do(context.Background(), client.Cmd.Get().Key("k").Build()).Error(); err != ErrConnClosing {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestHashObjectShardedClientAdapter(t *testing.T) {
+	m := &MockConn{}
+	client, err := newShardedClient(ShardedClientOption{Addresses: []string{":0"}}, func(dst string, opt ConnOption) conn {
+		return m
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	adapter := &hashObjectShardedClientAdapter{c: client}
+
+	m.DoFn = func(cmd cmds.Completed) proto.Result {
+		if v := strings.Join(cmd.Commands(), " "); v != "HSET k a b" {
+			return proto.NewResult(proto.Message{Type: '-', String: "wrong command " + v}, nil)
+		}
+		return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+	}
+	if err := adapter.Save(context.Background(), "k", map[string]string{"a": "b"}); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+
+	m.DoCacheFn = func(cmd cmds.Cacheable, ttl time.Duration) proto.Result {
+		if v := strings.Join(cmd.Commands(), " "); v != "HGETALL k" {
+			return proto.NewResult(proto.Message{Type: '-', String: "wrong command " + v}, nil)
+		}
+		return proto.NewResult(proto.Message{Type: '%', Values: []proto.Message{
+			{Type: '+', String: "a"},
+			{Type: '+', String: "b"},
+		}}, nil)
+	}
+	if v, err := adapter.FetchCache(context.Background(), "k", 100); err != nil || v["a"].String != "b" {
+		t.Fatalf("unexpected response %v", err)
+	}
+
+	m.DoFn = func(cmd cmds.Completed) proto.Result {
+		if v := strings.Join(cmd.Commands(), " "); v != "DEL k" {
+			return proto.NewResult(proto.Message{Type: '-', String: "wrong command " + v}, nil)
+		}
+		return proto.NewResult(proto.Message{Type: ':', Integer: 1}, nil)
+	}
+	if err := adapter.Remove(context.Background(), "k"); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+
+	if repo := client.NewHashRepository("", schema{}); repo == nil {
+		t.Fatalf("unexpected nil repository")
+	}
+}
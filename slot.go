@@ -0,0 +1,63 @@
+package rueidis
+
+import "github.com/rueian/rueidis/internal/cmds"
+
+// Slot returns the cluster hash slot of the key, respecting the hash tag.
+func Slot(key string) uint16 {
+	return cmds.Slot(key)
+}
+
+// HashTag returns the hash tag of the key, which is the non-empty part between the first { and the following }.
+// Keys with the same hash tag are in the same slot. The ok is false if the key has no hash tag.
+func HashTag(key string) (tag string, ok bool) {
+	return cmds.HashTag(key)
+}
+
+// GroupBySlot groups the keys by their slots. The order of the keys in each group is kept.
+func GroupBySlot(keys []string) map[uint16][]string {
+	groups := make(map[uint16][]string)
+	for _, k := range keys {
+		s := cmds.Slot(k)
+		groups[s] = append(groups[s], k)
+	}
+	return groups
+}
+
+// GroupByNode groups the keys by the addresses of the masters serving their slots in the current topology of the client.
+// The topology is refreshed if a slot is not covered. The order of the keys in each group is kept.
+func GroupByNode(c *ClusterClient, keys []string) (map[string][]string, error) {
+	groups := make(map[string][]string)
+	addrs := make(map[uint16]string)
+	for _, k := range keys {
+		s := cmds.Slot(k)
+		addr, ok := addrs[s]
+		if !ok {
+			if addr, ok = c.addrOf(s); !ok {
+				if err := c.refresh(); err != nil {
+					return nil, err
+				}
+				if addr, ok = c.addrOf(s); !ok {
+					return nil, ErrNoSlot
+				}
+			}
+			addrs[s] = addr
+		}
+		groups[addr] = append(groups[addr], k)
+	}
+	return groups, nil
+}
+
+// addrOf returns the address of the master serving the slot. The slot and the address are resolved under the same lock,
+// so that they are consistent even if the topology is being refreshed.
+func (c *ClusterClient) addrOf(slot uint16) (string, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if cc := c.slots[slot]; cc != nil {
+		for addr, p := range c.conns {
+			if p == cc {
+				return addr, true
+			}
+		}
+	}
+	return "", false
+}
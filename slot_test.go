@@ -0,0 +1,74 @@
+package rueidis
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func TestSlot(t *testing.T) {
+	if Slot("123456789") != 0x31C3&16383 || Slot("{user1000}.following") != Slot("{user1000}.followers") {
+		t.Fatalf("unexpected slots")
+	}
+}
+
+func TestHashTag(t *testing.T) {
+	for key, tag := range map[string]string{
+		"{user1000}.following": "user1000",
+		"foo{}{bar}":           "",
+		"foo{{bar}}zap":        "{bar",
+		"foo{bar}{zap}":        "bar",
+		"foo":                  "",
+		"foo{bar":              "",
+	} {
+		if v, ok := HashTag(key); v != tag || ok != (tag != "") {
+			t.Fatalf("unexpected hash tag of %q: %q %v", key, v, ok)
+		}
+	}
+}
+
+func TestGroupBySlot(t *testing.T) {
+	groups := GroupBySlot([]string{"{a}1", "b", "{a}2"})
+	if len(groups) != 2 || !reflect.DeepEqual(groups[Slot("a")], []string{"{a}1", "{a}2"}) || !reflect.DeepEqual(groups[Slot("b")], []string{"b"}) {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+}
+
+func TestGroupByNode(t *testing.T) {
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:1"}}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			return slotsOf([]interface{}{0, 8191, 1}, []interface{}{8192, 16383, 2})
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	// the slots of "a", "b" and "c" are 15495, 3300 and 7365
+	groups, err := GroupByNode(client, []string{"a", "b", "c", "{a}1"})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if !reflect.DeepEqual(groups, map[string][]string{
+		"127.0.0.1:1": {"b", "c"},
+		"127.0.0.1:2": {"a", "{a}1"},
+	}) {
+		t.Fatalf("unexpected groups %v", groups)
+	}
+}
+
+func TestGroupByNodeNotCovered(t *testing.T) {
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{"127.0.0.1:1"}}, func(dst string, opt ConnOption) conn {
+		return &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+			return slotsOf([]interface{}{0, 8191, 1})
+		}}
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	// the slot of "a" is 15495, which is not covered
+	if groups, err := GroupByNode(client, []string{"b", "a"}); err != ErrNoSlot || groups != nil {
+		t.Fatalf("unexpected groups %v %v", groups, err)
+	}
+}
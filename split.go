@@ -0,0 +1,94 @@
+package rueidis
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+// SplitError is returned by the ClusterClient if some parts of a command built by the Cmd.Split() failed.
+// The replies of the other parts are still merged, and can be retrieved with the Value() of the result.
+// For MGET, the values of the keys in the failed parts are nil.
+type SplitError struct {
+	// Keys are the keys of each failed part, and Errs are their errors.
+	Keys [][]string
+	Errs []error
+}
+
+func (e *SplitError) Error() string {
+	return fmt.Sprintf("%d split commands failed, the first error: %v", len(e.Errs), e.Errs[0])
+}
+
+func (e *SplitError) Unwrap() error {
+	return e.Errs[0]
+}
+
+// doSplit splits the MultiSlot cmd by slots, runs the parts with the fn concurrently, and merges their replies.
+func doSplit(cmd cmds.SCompleted, fn func(part cmds.SCompleted) proto.Result) proto.Result {
+	parts, indexes := cmd.Split()
+	results := make([]proto.Result, len(parts))
+	wg := sync.WaitGroup{}
+	wg.Add(len(parts))
+	for i := range parts {
+		go func(i int) {
+			results[i] = fn(parts[i])
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+
+	var serr *SplitError
+	var merged proto.Message
+	switch cmd.Commands()[0] {
+	case "MGET":
+		merged.Type = '*'
+		merged.Values = make([]proto.Message, len(cmd.Commands())-1)
+		for i := range merged.Values {
+			merged.Values[i].Type = '_'
+		}
+	case "MSET":
+		merged.Type, merged.String = '+', "OK"
+	default:
+		merged.Type = ':'
+	}
+	for i, r := range results {
+		v, err := r.Value()
+		if err == nil && merged.Type == '*' && len(v.Values) != len(indexes[i]) {
+			err = fmt.Errorf("unexpected %d values for %d keys", len(v.Values), len(indexes[i]))
+		}
+		if err != nil {
+			if serr == nil {
+				serr = &SplitError{}
+			}
+			serr.Keys = append(serr.Keys, keysOf(parts[i]))
+			serr.Errs = append(serr.Errs, err)
+			continue
+		}
+		switch merged.Type {
+		case '*':
+			for j, k := range indexes[i] {
+				merged.Values[k] = v.Values[j]
+			}
+		case ':':
+			merged.Integer += v.Integer
+		}
+	}
+	if serr != nil {
+		return proto.NewResult(merged, serr)
+	}
+	return proto.NewResult(merged, nil)
+}
+
+func keysOf(part cmds.SCompleted) []string {
+	cs := part.Commands()
+	if cs[0] != "MSET" {
+		return append([]string(nil), cs[1:]...)
+	}
+	keys := make([]string, 0, len(cs)/2)
+	for i := 1; i < len(cs); i += 2 {
+		keys = append(keys, cs[i])
+	}
+	return keys
+}
@@ -0,0 +1,90 @@
+package rueidis
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func TestClusterClientSplit(t *testing.T) {
+	v := errors.New("part err")
+	m := &MockConn{DoFn: func(cmd cmds.Completed) proto.Result {
+		cs := cmd.Commands()
+		switch cs[0] {
+		case "CLUSTER":
+			return slotsResp
+		case "MGET":
+			if cs[1] == "fail" {
+				return proto.NewErrResult(v)
+			}
+			values := make([]proto.Message, len(cs)-1)
+			for i, k := range cs[1:] {
+				values[i] = proto.Message{Type: '+', String: strings.ToUpper(k)}
+			}
+			return proto.NewResult(proto.Message{Type: '*', Values: values}, nil)
+		case "MSET":
+			return proto.NewResult(proto.Message{Type: '+', String: "OK"}, nil)
+		}
+		return proto.NewResult(proto.Message{Type: ':', Integer: int64(len(cs) - 1)}, nil)
+	}}
+	client, err := newClusterClient(ClusterClientOption{InitAddress: []string{":0"}}, func(dst string, opt ConnOption) conn {
+		return m
+	})
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	ctx := context.Background()
+
+	t.Run("MGET", func(t *testing.T) {
+		vs, err := client.Do(ctx, client.Cmd.Split().Mget().Key("a", "b", "{a}1", "c").Build()).ToArray()
+		if err != nil {
+			t.Fatalf("unexpected err %v", err)
+		}
+		for i, k := range []string{"A", "B", "{A}1", "C"} {
+			if s, _ := vs[i].ToString(); s != k {
+				t.Fatalf("unexpected value %v at %d", s, i)
+			}
+		}
+	})
+
+	t.Run("MSET", func(t *testing.T) {
+		if s, err := client.Do(ctx, client.Cmd.Split().Mset().KeyValue().KeyValue("a", "1").KeyValue("b", "2").Build()).ToString(); err != nil || s != "OK" {
+			t.Fatalf("unexpected resp %v %v", s, err)
+		}
+	})
+
+	t.Run("Counts", func(t *testing.T) {
+		for _, cmd := range []cmds.SCompleted{
+			client.Cmd.Split().Del().Key("a", "b", "c").Build(),
+			client.Cmd.Split().Exists().Key("a", "b", "c").Build(),
+			client.Cmd.Split().Unlink().Key("a", "b", "c").Build(),
+			client.Cmd.Split().Touch().Key("a", "b", "c").Build(),
+		} {
+			if n, err := client.Do(ctx, cmd).ToInt64(); err != nil || n != 3 {
+				t.Fatalf("unexpected resp %v %v", n, err)
+			}
+		}
+	})
+
+	t.Run("Part error", func(t *testing.T) {
+		resp := client.Do(ctx, client.Cmd.Split().Mget().Key("a", "fail").Build())
+		var serr *SplitError
+		if err := resp.Error(); !errors.As(err, &serr) || !errors.Is(err, v) || len(serr.Keys) != 1 || serr.Keys[0][0] != "fail" {
+			t.Fatalf("unexpected err %v", err)
+		}
+		msg, _ := resp.Value()
+		if s, _ := msg.Values[0].ToString(); s != "A" || !msg.Values[1].IsNil() {
+			t.Fatalf("unexpected values %v", msg.Values)
+		}
+	})
+
+	t.Run("Not supported", func(t *testing.T) {
+		if _, err := client.DoStream(ctx, client.Cmd.Split().Mget().Key("a", "b").Build()).WriteTo(&strings.Builder{}); err != ErrMultiSlot {
+			t.Fatalf("unexpected err %v", err)
+		}
+	})
+}
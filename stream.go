@@ -0,0 +1,20 @@
+package rueidis
+
+import (
+	"io"
+)
+
+// ResultStream is returned by the DoStream. The command is sent when the WriteTo is called,
+// and its blob string reply is copied to the writer directly from the socket without being buffered fully.
+// Each call to the WriteTo sends the command again.
+type ResultStream struct {
+	fn func(w io.Writer) (int64, error)
+}
+
+var _ io.WriterTo = ResultStream{}
+
+// WriteTo sends the command and copies its reply to the w. A nil reply results in a redis nil error,
+// which can be checked by the IsRedisNil, and a reply other than a blob string results in a *ParseError.
+func (s ResultStream) WriteTo(w io.Writer) (n int64, err error) {
+	return s.fn(w)
+}
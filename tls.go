@@ -0,0 +1,98 @@
+package rueidis
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+var ErrNoCACerts = errors.New("no CA certificate found in the CAFile")
+
+// TLSFiles loads the client certificate, key and CA bundle from files for mutual TLS.
+// The files are checked on every new connection, including pooled ones, and reloaded if they are changed,
+// so that rotated certificates are picked up by new connections while the existing ones are kept.
+type TLSFiles struct {
+	// CertFile and KeyFile are the PEM encoded client certificate and key.
+	CertFile string
+	KeyFile  string
+	// CAFile is the PEM encoded CA bundle to verify the server. The system pool is used if it is empty.
+	CAFile string
+
+	// OnReloadError is called when the changed files failed to be loaded. The previously loaded ones are still used.
+	OnReloadError func(err error)
+
+	mu     sync.Mutex
+	stamp  string
+	config *tls.Config
+}
+
+func (f *TLSFiles) load(base *tls.Config) (*tls.Config, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	stamp, err := f.stat()
+	if err == nil && stamp == f.stamp && f.config != nil {
+		return f.config, nil
+	}
+	var config *tls.Config
+	if err == nil {
+		config, err = f.read(base)
+	}
+	if err != nil {
+		if f.config == nil {
+			return nil, err
+		}
+		if f.OnReloadError != nil {
+			f.OnReloadError(err)
+		}
+		return f.config, nil
+	}
+	f.stamp, f.config = stamp, config
+	return config, nil
+}
+
+func (f *TLSFiles) stat() (string, error) {
+	sb := strings.Builder{}
+	for _, name := range []string{f.CertFile, f.KeyFile, f.CAFile} {
+		if name == "" {
+			continue
+		}
+		info, err := os.Stat(name)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(fmt.Sprintf("%s:%d:%d;", name, info.ModTime().UnixNano(), info.Size()))
+	}
+	return sb.String(), nil
+}
+
+func (f *TLSFiles) read(base *tls.Config) (config *tls.Config, err error) {
+	if base != nil {
+		config = base.Clone()
+	} else {
+		config = &tls.Config{}
+	}
+	if f.CertFile != "" || f.KeyFile != "" {
+		cert, err := tls.LoadX509KeyPair(f.CertFile, f.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	if f.CAFile != "" {
+		pem, err := os.ReadFile(f.CAFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, ErrNoCACerts
+		}
+		config.RootCAs = pool
+	}
+	return config, nil
+}
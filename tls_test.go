@@ -0,0 +1,128 @@
+package rueidis
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeCert(t *testing.T, dir string, serial int64) (certFile, keyFile string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	tpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "rueidis"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tpl, tpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	kder, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	certFile, keyFile = filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem")
+	if err = os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if err = os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: kder}), 0600); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	// make sure the modification time is changed
+	mod := time.Now().Add(time.Duration(serial) * time.Second)
+	_ = os.Chtimes(certFile, mod, mod)
+	_ = os.Chtimes(keyFile, mod, mod)
+	return certFile, keyFile
+}
+
+func serialOf(t *testing.T, config *tls.Config) int64 {
+	cert, err := x509.ParseCertificate(config.Certificates[0].Certificate[0])
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	return cert.SerialNumber.Int64()
+}
+
+func TestTLSFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, 1)
+
+	var reloadErr error
+	files := &TLSFiles{CertFile: certFile, KeyFile: keyFile, CAFile: certFile, OnReloadError: func(err error) {
+		reloadErr = err
+	}}
+
+	base := &tls.Config{ServerName: "base"}
+	config, err := files.load(base)
+	if err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if config.ServerName != "base" || config.RootCAs == nil || serialOf(t, config) != 1 {
+		t.Fatalf("unexpected config %v", config)
+	}
+	if again, _ := files.load(base); again != config {
+		t.Fatalf("config should be reused if files are not changed")
+	}
+
+	writeCert(t, dir, 2)
+	if config, err = files.load(base); err != nil || serialOf(t, config) != 2 {
+		t.Fatalf("unexpected reload result %v %v", config, err)
+	}
+
+	if err = os.WriteFile(keyFile, []byte("broken"), 0600); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if prev, err := files.load(base); err != nil || prev != config || reloadErr == nil {
+		t.Fatalf("previous config should be used on reload error %v %v", err, reloadErr)
+	}
+}
+
+func TestTLSFilesError(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := (&TLSFiles{CertFile: filepath.Join(dir, "missing")}).load(nil); err == nil {
+		t.Fatalf("unexpected nil err")
+	}
+	ca := filepath.Join(dir, "ca.pem")
+	if err := os.WriteFile(ca, []byte("not a pem"), 0600); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if _, err := (&TLSFiles{CAFile: ca}).load(nil); err != ErrNoCACerts {
+		t.Fatalf("unexpected err %v", err)
+	}
+	if _, err := dial("127.0.0.1:0", ConnOption{TLSFiles: &TLSFiles{CAFile: ca}}); err != ErrNoCACerts {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
+
+func TestDialTLSFiles(t *testing.T) {
+	dir := t.TempDir()
+	certFile, keyFile := writeCert(t, dir, 1)
+	files := &TLSFiles{CertFile: certFile, KeyFile: keyFile}
+	n1, n2 := net.Pipe()
+	defer n2.Close()
+	if _, err := dial("127.0.0.1:0", ConnOption{TLSFiles: files, DialFn: func(network, addr string, dialer *net.Dialer, tlsConfig *tls.Config) (net.Conn, error) {
+		if tlsConfig == nil || len(tlsConfig.Certificates) != 1 {
+			t.Fatalf("unexpected tls config %v", tlsConfig)
+		}
+		return n1, nil
+	}}); err != nil {
+		t.Fatalf("unexpected err %v", err)
+	}
+}
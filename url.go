@@ -0,0 +1,132 @@
+package rueidis
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrMultipleHosts = errors.New("redis url with multiple hosts is only supported by ParseURL")
+
+// ParseURL parses a redis url into ClusterClientOption. The supported formats are:
+//
+//	redis://[[user]:password@]host[:port][,host2[:port2]...][/db][?params]
+//	rediss://[[user]:password@]host[:port][,host2[:port2]...][/db][?params]
+//	unix://[[user]:password@]/path/to/redis.sock[?params]
+//
+// The supported params are:
+//
+//	addr: additional hosts, can be repeated
+//	db: the database number, alternative to the /db path
+//	client_name: ConnOption.ClientName
+//	dial_timeout: ConnOption.DialTimeout, in the time.ParseDuration format
+//	cache_size: ConnOption.CacheSizeEachConn in bytes
+//	pool_size: ConnOption.BlockingPoolSize
+//	pipeline_multiplex: ConnOption.PipelineMultiplex
+//	check_server_version: ConnOption.CheckServerVersion
+//	skip_verify: set InsecureSkipVerify of the ConnOption.TLSConfig for rediss
+//
+// Any other param results in an error.
+func ParseURL(str string) (opt ClusterClientOption, err error) {
+	opt.InitAddress, opt.ConnOption, err = parseURL(str)
+	return opt, err
+}
+
+// ParseSingleURL parses a redis url into SingleClientOption with the same formats as ParseURL.
+// It returns ErrMultipleHosts if the url contains more than one host.
+func ParseSingleURL(str string) (opt SingleClientOption, err error) {
+	var addresses []string
+	if addresses, opt.ConnOption, err = parseURL(str); err != nil {
+		return opt, err
+	}
+	if len(addresses) != 1 {
+		return opt, ErrMultipleHosts
+	}
+	opt.Address = addresses[0]
+	return opt, nil
+}
+
+func parseURL(str string) (addresses []string, opt ConnOption, err error) {
+	u, err := url.Parse(str)
+	if err != nil {
+		return nil, opt, err
+	}
+	if u.User != nil {
+		opt.Username = u.User.Username()
+		if password, ok := u.User.Password(); ok {
+			opt.Password = password
+			if opt.Username == "" {
+				opt.Username = "default"
+			}
+		}
+	}
+	q := u.Query()
+	switch u.Scheme {
+	case "unix":
+		if u.Path == "" {
+			return nil, opt, fmt.Errorf("redis url %q has no unix socket path", str)
+		}
+		addresses = append(addresses, "unix://"+u.Path)
+	case "rediss":
+		opt.TLSConfig = &tls.Config{}
+		fallthrough
+	case "redis":
+		for _, host := range append(strings.Split(u.Host, ","), q["addr"]...) {
+			if host == "" {
+				continue
+			}
+			if _, _, err := net.SplitHostPort(host); err != nil {
+				host = net.JoinHostPort(strings.Trim(host, "[]"), "6379")
+			}
+			addresses = append(addresses, host)
+		}
+		if len(addresses) == 0 {
+			return nil, opt, fmt.Errorf("redis url %q has no host", str)
+		}
+		if db := strings.Trim(u.Path, "/"); db != "" {
+			if opt.SelectDB, err = strconv.Atoi(db); err != nil {
+				return nil, opt, fmt.Errorf("redis url %q has invalid db: %w", str, err)
+			}
+		}
+	default:
+		return nil, opt, fmt.Errorf("redis url %q has unsupported scheme %q", str, u.Scheme)
+	}
+	for k, vs := range q {
+		v := vs[len(vs)-1]
+		switch k {
+		case "addr":
+		case "db":
+			opt.SelectDB, err = strconv.Atoi(v)
+		case "client_name":
+			opt.ClientName = v
+		case "dial_timeout":
+			opt.DialTimeout, err = time.ParseDuration(v)
+		case "cache_size":
+			opt.CacheSizeEachConn, err = strconv.Atoi(v)
+		case "pool_size":
+			opt.BlockingPoolSize, err = strconv.Atoi(v)
+		case "pipeline_multiplex":
+			opt.PipelineMultiplex, err = strconv.Atoi(v)
+		case "check_server_version":
+			opt.CheckServerVersion, err = strconv.ParseBool(v)
+		case "skip_verify":
+			var skip bool
+			if skip, err = strconv.ParseBool(v); err == nil && opt.TLSConfig != nil {
+				opt.TLSConfig.InsecureSkipVerify = skip
+			} else if err == nil {
+				err = errors.New("only supported by rediss")
+			}
+		default:
+			return nil, opt, fmt.Errorf("redis url %q has unknown parameter %q", str, k)
+		}
+		if err != nil {
+			return nil, opt, fmt.Errorf("redis url %q has invalid parameter %q: %w", str, k, err)
+		}
+	}
+	return addresses, opt, nil
+}
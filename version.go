@@ -0,0 +1,75 @@
+package rueidis
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/rueian/rueidis/internal/cmds"
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+// CommandMeta is the metadata of a command from the redis command specs.
+type CommandMeta = cmds.Meta
+
+// LookupCommand returns the CommandMeta of the command, ex. LookupCommand("CLIENT", "PAUSE").
+// The tokens can also be a full command, such as the cmds.Completed.Commands().
+func LookupCommand(tokens ...string) (CommandMeta, bool) {
+	return cmds.LookupMeta(tokens)
+}
+
+// VersionError is returned without sending the command if the ConnOption.CheckServerVersion is enabled
+// and the command is introduced in a newer redis version than the server's.
+type VersionError struct {
+	Command       string
+	Since         string
+	ServerVersion string
+}
+
+func (e *VersionError) Error() string {
+	return fmt.Sprintf("%s requires redis %s or later, but the server version is %s", e.Command, e.Since, e.ServerVersion)
+}
+
+// serverVersion returns the version field of the HELLO reply, or an empty string if it is missing.
+func serverVersion(info map[string]proto.Message) string {
+	return info["version"].String
+}
+
+func checkServerVersion(version string, cmd []string) error {
+	if version == "" {
+		return nil
+	}
+	meta, ok := cmds.LookupMeta(cmd)
+	if !ok || meta.Module != "" || meta.Since == "" {
+		return nil
+	}
+	if compareVersion(version, meta.Since) < 0 {
+		return &VersionError{Command: meta.Name, Since: meta.Since, ServerVersion: version}
+	}
+	return nil
+}
+
+// compareVersion compares two dot separated versions numerically, and the missing or non-numeric parts are taken as 0.
+func compareVersion(a, b string) int {
+	for a != "" || b != "" {
+		var x, y int
+		x, a = versionPart(a)
+		y, b = versionPart(b)
+		if x != y {
+			if x < y {
+				return -1
+			}
+			return 1
+		}
+	}
+	return 0
+}
+
+func versionPart(v string) (int, string) {
+	part, rest := v, ""
+	if i := strings.IndexByte(v, '.'); i >= 0 {
+		part, rest = v[:i], v[i+1:]
+	}
+	n, _ := strconv.Atoi(part)
+	return n, rest
+}
@@ -0,0 +1,62 @@
+package rueidis
+
+import (
+	"testing"
+
+	"github.com/rueian/rueidis/internal/proto"
+)
+
+func TestCompareVersion(t *testing.T) {
+	for _, c := range []struct {
+		a, b string
+		r    int
+	}{
+		{"7.0.0", "7.0.0", 0},
+		{"7.0", "7.0.0", 0},
+		{"6.2.6", "7.0.0", -1},
+		{"6.10.0", "6.9.0", 1},
+		{"2.9.50", "3.0.0", -1},
+		{"255.255.255", "7.0.0", 1},
+	} {
+		if r := compareVersion(c.a, c.b); r != c.r {
+			t.Fatalf("unexpected compareVersion(%q, %q) %v", c.a, c.b, r)
+		}
+	}
+}
+
+func TestLookupCommand(t *testing.T) {
+	if meta, ok := LookupCommand("client", "pause", "10"); !ok || meta.Name != "CLIENT PAUSE" || meta.Group != "connection" {
+		t.Fatalf("unexpected meta %v %v", meta, ok)
+	}
+	if meta, ok := LookupCommand("GET", "a"); !ok || meta.Name != "GET" || meta.Since != "1.0.0" || meta.Complexity != "O(1)" {
+		t.Fatalf("unexpected meta %v %v", meta, ok)
+	}
+	if meta, ok := LookupCommand("MSET"); !ok || meta.FirstKey != 1 || meta.LastKey != -1 || meta.KeyStep != 2 || meta.MovableKeys {
+		t.Fatalf("unexpected meta %v %v", meta, ok)
+	}
+	if meta, ok := LookupCommand("EVAL"); !ok || meta.FirstKey != 0 || !meta.MovableKeys {
+		t.Fatalf("unexpected meta %v %v", meta, ok)
+	}
+	if meta, ok := LookupCommand("JSON.GET"); !ok || meta.Module != "json" {
+		t.Fatalf("unexpected meta %v %v", meta, ok)
+	}
+	if _, ok := LookupCommand("UNKNOWN"); ok {
+		t.Fatalf("unexpected meta of unknown command")
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	info := map[string]proto.Message{"version": {Type: '+', String: "6.0.0"}}
+	for _, cmd := range [][]string{{"GET", "a"}, {"JSON.GET", "a"}, {"UNKNOWN"}} {
+		if err := checkServerVersion(serverVersion(info), cmd); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	if err := checkServerVersion(serverVersion(nil), []string{"LMPOP"}); err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	err := checkServerVersion(serverVersion(info), []string{"CLIENT", "NO-EVICT", "ON"})
+	if err == nil || err.Error() != "CLIENT NO-EVICT requires redis 7.0.0 or later, but the server version is 6.0.0" {
+		t.Fatalf("unexpected error %v", err)
+	}
+}